@@ -8,6 +8,7 @@ require (
 	github.com/yoheimuta/go-protoparser/v4 v4.2.0
 	google.golang.org/grpc v1.25.1
 	gopkg.in/yaml.v2 v2.2.5
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 go 1.13
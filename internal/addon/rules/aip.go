@@ -0,0 +1,311 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// The standard methods of https://google.aip.dev/121.
+const (
+	standardMethodGet    = "Get"
+	standardMethodList   = "List"
+	standardMethodCreate = "Create"
+	standardMethodUpdate = "Update"
+	standardMethodDelete = "Delete"
+)
+
+var standardMethods = []string{
+	standardMethodGet,
+	standardMethodList,
+	standardMethodCreate,
+	standardMethodUpdate,
+	standardMethodDelete,
+}
+
+// standardHTTPMethods are the HTTP methods of the standard methods.
+var standardHTTPMethods = map[string]string{
+	standardMethodGet:    "get",
+	standardMethodList:   "get",
+	standardMethodCreate: "post",
+	standardMethodUpdate: "patch",
+	standardMethodDelete: "delete",
+}
+
+// standardMethod returns the standard method of the RPC, e.g. Get of GetBook.
+// It returns empty for the custom methods. BatchGetBooks and Getaway are not standard methods.
+func standardMethod(rpcName string) string {
+	for _, method := range standardMethods {
+		rest := strings.TrimPrefix(rpcName, method)
+		if rest == rpcName {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(rest); unicode.IsUpper(r) {
+			return method
+		}
+	}
+	return ""
+}
+
+const httpOptionName = "(google.api.http)"
+
+// httpRule is the binding of the option (google.api.http).
+// See https://github.com/googleapis/googleapis/blob/master/google/api/http.proto.
+type httpRule struct {
+	// method is the lower case HTTP method, e.g. get. The custom method is its kind in lower case.
+	method string
+	path   string
+	body   string
+}
+
+// rpcHTTPRules returns the bindings of the option (google.api.http) of the RPC, including the additional bindings.
+// The first binding is the primary one. It returns the option too, which is nil if the RPC has no binding.
+// It returns an error if the option is malformed.
+func rpcHTTPRules(rpc *parser.RPC) ([]httpRule, *parser.Option, error) {
+	var fields []textField
+	var option *parser.Option
+	for _, o := range rpc.Options {
+		switch {
+		case o.OptionName == httpOptionName:
+			message, err := parseTextMessage(o.Constant)
+			if err != nil {
+				return nil, o, err
+			}
+			fields = append(fields, message...)
+		case strings.HasPrefix(o.OptionName, httpOptionName+"."):
+			fields = append(fields, textField{
+				name:  strings.TrimPrefix(o.OptionName, httpOptionName+"."),
+				value: unquote(o.Constant),
+			})
+		default:
+			continue
+		}
+		if option == nil {
+			option = o
+		}
+	}
+	if option == nil {
+		return nil, nil, nil
+	}
+
+	primary, additionalBindings := newHTTPRule(fields)
+	bindings := []httpRule{primary}
+	for _, field := range additionalBindings {
+		binding, _ := newHTTPRule(field.message)
+		bindings = append(bindings, binding)
+	}
+	return bindings, option, nil
+}
+
+func newHTTPRule(fields []textField) (httpRule, []textField) {
+	var binding httpRule
+	var additionalBindings []textField
+	for _, field := range fields {
+		switch field.name {
+		case "get", "put", "post", "delete", "patch":
+			binding.method = field.name
+			binding.path = field.value
+		case "custom":
+			for _, f := range field.message {
+				switch f.name {
+				case "kind":
+					binding.method = strings.ToLower(f.value)
+				case "path":
+					binding.path = f.value
+				}
+			}
+		case "body":
+			binding.body = field.value
+		case "additional_bindings":
+			additionalBindings = append(additionalBindings, field)
+		}
+	}
+	return binding, additionalBindings
+}
+
+var pathVariable = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+// pathVariables returns the field paths of the variables in the path template, e.g. book.name of /v1/{book.name=books/*}.
+func pathVariables(path string) []string {
+	var variables []string
+	for _, match := range pathVariable.FindAllStringSubmatch(path, -1) {
+		variables = append(variables, strings.TrimSpace(match[1]))
+	}
+	return variables
+}
+
+// textField is a field of the message literal in the option constant, e.g. get: "/v1/books".
+// The field has either the value or the message.
+type textField struct {
+	name    string
+	value   string
+	message []textField
+}
+
+// parseTextMessage parses the message literal in the option constant like {get:"/v1/books" body:"*"}.
+// The list values like additional_bindings:[{...},{...}] are flattened into the fields with the same name.
+func parseTextMessage(constant string) ([]textField, error) {
+	p := &textParser{tokens: tokenizeText(constant)}
+	if p.next() != "{" {
+		return nil, fmt.Errorf("found %q, but want a message literal", constant)
+	}
+	fields, err := p.parseFields()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != "" {
+		return nil, fmt.Errorf("found %q after the message literal %q", p.peek(), constant)
+	}
+	return fields, nil
+}
+
+type textParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *textParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *textParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+// parseFields parses the fields until the closing curly.
+func (p *textParser) parseFields() ([]textField, error) {
+	var fields []textField
+	for {
+		switch token := p.next(); token {
+		case "}":
+			return fields, nil
+		case ",", ";":
+			continue
+		case "", "{", "[", "]", ":":
+			return nil, fmt.Errorf("found %q, but want a field name", token)
+		default:
+			if p.peek() == ":" {
+				p.next()
+			}
+			values, err := p.parseValues(token)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, values...)
+		}
+	}
+}
+
+// parseValues parses the value, the message or the list of them.
+func (p *textParser) parseValues(name string) ([]textField, error) {
+	switch token := p.next(); token {
+	case "{":
+		message, err := p.parseFields()
+		if err != nil {
+			return nil, err
+		}
+		return []textField{{name: name, message: message}}, nil
+	case "[":
+		var fields []textField
+		for {
+			switch p.peek() {
+			case "]":
+				p.next()
+				return fields, nil
+			case ",":
+				p.next()
+				continue
+			}
+			values, err := p.parseValues(name)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, values...)
+		}
+	case "", "}", "]", ":", ",", ";":
+		return nil, fmt.Errorf("found %q, but want a value of %q", token, name)
+	default:
+		return []textField{{name: name, value: unquote(token)}}, nil
+	}
+}
+
+// tokenizeText splits the message literal into the punctuations, the quoted strings and the words.
+func tokenizeText(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("{}[]:,;", c) != -1:
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if len(s) <= j {
+				j = len(s) - 1
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && strings.IndexByte(" \t\n\r{}[]:,;\"'", s[j]) == -1 {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+const (
+	fieldBehaviorOptionName = "(google.api.field_behavior)"
+	resourceOptionName      = "(google.api.resource)"
+)
+
+// fieldBehaviors are the values of google.api.FieldBehavior.
+// See https://github.com/googleapis/googleapis/blob/master/google/api/field_behavior.proto.
+var fieldBehaviors = []string{
+	"FIELD_BEHAVIOR_UNSPECIFIED",
+	"OPTIONAL",
+	"REQUIRED",
+	"OUTPUT_ONLY",
+	"INPUT_ONLY",
+	"IMMUTABLE",
+	"UNORDERED_LIST",
+	"NON_EMPTY_DEFAULT",
+	"IDENTIFIER",
+}
+
+// fieldBehaviorsOf returns the values of the option (google.api.field_behavior) of the field.
+// Both the repeated options and the list like [REQUIRED, IMMUTABLE] are supported.
+func fieldBehaviorsOf(options []*parser.FieldOption) []string {
+	var behaviors []string
+	for _, option := range options {
+		if option.OptionName != fieldBehaviorOptionName {
+			continue
+		}
+		for _, token := range tokenizeText(option.Constant) {
+			if strings.IndexByte("[],", token[0]) == -1 {
+				behaviors = append(behaviors, strings.TrimPrefix(token, "google.api."))
+			}
+		}
+	}
+	return behaviors
+}
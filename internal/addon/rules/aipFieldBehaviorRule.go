@@ -0,0 +1,180 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/strs"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// conflictingFieldBehaviors are the pairs of the field behaviors which contradict each other.
+var conflictingFieldBehaviors = [][2]string{
+	{"REQUIRED", "OPTIONAL"},
+	{"REQUIRED", "OUTPUT_ONLY"},
+	{"INPUT_ONLY", "OUTPUT_ONLY"},
+}
+
+// AIPFieldBehaviorRule verifies the option (google.api.field_behavior).
+//
+//   - The values are known and don't contradict each other.
+//   - The fields identifying the resources in the requests of the standard methods are REQUIRED.
+//     They are name of Get and Delete, parent of List and Create, and the resource field of Create and Update.
+//
+// The standard methods are looked up from the proto and the linted set.
+// See https://google.aip.dev/203.
+type AIPFieldBehaviorRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewAIPFieldBehaviorRule creates a new AIPFieldBehaviorRule.
+// The standard methods are looked up from protoSet as well.
+func NewAIPFieldBehaviorRule(
+	protoSet *file.ParsedProtoSet,
+) AIPFieldBehaviorRule {
+	return AIPFieldBehaviorRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r AIPFieldBehaviorRule) ID() string {
+	return "AIP_FIELD_BEHAVIOR"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPFieldBehaviorRule) Purpose() string {
+	return "Verifies the option (google.api.field_behavior) and that the request fields identifying the resources are REQUIRED."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPFieldBehaviorRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPFieldBehaviorRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The client libraries take the REQUIRED fields as the arguments of the methods, and the gateways validate them. A missing annotation makes a mandatory field look optional.",
+		BadExample: `message GetBookRequest {
+  string name = 1;
+}`,
+		GoodExample: `message GetBookRequest {
+  string name = 1 [(google.api.field_behavior) = REQUIRED];
+}`,
+		StyleGuideURL: "https://google.aip.dev/203",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPFieldBehaviorRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	messages := newMessageIndex(proto, r.protoSet)
+	v := &aipFieldBehaviorVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		requiredFields: make(map[parser.Visitee]string),
+	}
+	forEachRPC(proto, r.protoSet, func(rpc *parser.RPC, pkg string) {
+		v.collectRequiredFields(rpc, messages, pkg)
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipFieldBehaviorVisitor struct {
+	*visitor.BaseAddVisitor
+	// requiredFields are the RPC names keyed by the required fields of their requests.
+	requiredFields map[parser.Visitee]string
+}
+
+func (v *aipFieldBehaviorVisitor) collectRequiredFields(rpc *parser.RPC, messages messageIndex, pkg string) {
+	var names []string
+	switch method := standardMethod(rpc.RPCName); method {
+	case standardMethodGet, standardMethodDelete:
+		names = append(names, "name")
+	case standardMethodList:
+		names = append(names, "parent")
+	case standardMethodCreate, standardMethodUpdate:
+		if method == standardMethodCreate {
+			names = append(names, "parent")
+		}
+		names = append(names, resourceField(rpc))
+	default:
+		return
+	}
+
+	request, _ := messages.resolve(rpc.RPCRequest.MessageType, pkg)
+	if request == nil {
+		return
+	}
+	for _, element := range request.MessageBody {
+		switch e := element.(type) {
+		case *parser.Field:
+			v.addRequiredField(e, e.FieldName, names, rpc.RPCName)
+		case *parser.MapField:
+			v.addRequiredField(e, e.MapName, names, rpc.RPCName)
+		case *parser.Oneof:
+			for _, f := range e.OneofFields {
+				v.addRequiredField(f, f.FieldName, names, rpc.RPCName)
+			}
+		}
+	}
+}
+
+func (v *aipFieldBehaviorVisitor) addRequiredField(field parser.Visitee, fieldName string, names []string, rpcName string) {
+	if _, ok := v.requiredFields[field]; ok || !stringsutil.ContainsStringInSlice(fieldName, names) {
+		return
+	}
+	v.requiredFields[field] = rpcName
+}
+
+// resourceField returns the resource field in the request of Create or Update.
+// It is the body of the option (google.api.http), or the response in lower snake case without the body like "*".
+func resourceField(rpc *parser.RPC) string {
+	bindings, option, err := rpcHTTPRules(rpc)
+	if err == nil && option != nil {
+		if body := bindings[0].body; body != "" && body != "*" {
+			return body
+		}
+	}
+	return strings.ToLower(strs.ToUpperSnakeCase(messageTypeName(rpc.RPCResponse.MessageType)))
+}
+
+// VisitField checks the field.
+func (v *aipFieldBehaviorVisitor) VisitField(field *parser.Field) bool {
+	v.check(field, field.FieldName, field.FieldOptions, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *aipFieldBehaviorVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field, field.MapName, field.FieldOptions, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *aipFieldBehaviorVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field, field.FieldName, field.FieldOptions, field.Meta.Pos)
+	return false
+}
+
+func (v *aipFieldBehaviorVisitor) check(field parser.Visitee, fieldName string, options []*parser.FieldOption, pos meta.Position) {
+	behaviors := fieldBehaviorsOf(options)
+	for _, behavior := range behaviors {
+		if !stringsutil.ContainsStringInSlice(behavior, fieldBehaviors) {
+			v.AddFailuref(pos, "Field %q has the unknown field behavior %q", fieldName, behavior)
+		}
+	}
+	for _, pair := range conflictingFieldBehaviors {
+		if stringsutil.ContainsStringInSlice(pair[0], behaviors) && stringsutil.ContainsStringInSlice(pair[1], behaviors) {
+			v.AddFailuref(pos, "Field %q should not be both %s and %s", fieldName, pair[0], pair[1])
+		}
+	}
+	if rpcName, ok := v.requiredFields[field]; ok && !stringsutil.ContainsStringInSlice("REQUIRED", behaviors) {
+		v.AddFailuref(pos, "Field %q of the request of the RPC %q should have the field behavior REQUIRED", fieldName, rpcName)
+	}
+}
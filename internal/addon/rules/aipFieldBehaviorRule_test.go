@@ -0,0 +1,215 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestAIPFieldBehaviorRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   300,
+		Line:     15,
+		Column:   3,
+	}
+
+	newField := func(typ, name string, behaviors ...string) *parser.Field {
+		var options []*parser.FieldOption
+		for _, behavior := range behaviors {
+			options = append(options, &parser.FieldOption{
+				OptionName: "(google.api.field_behavior)",
+				Constant:   behavior,
+			})
+		}
+		return &parser.Field{
+			Type:         typ,
+			FieldName:    name,
+			FieldOptions: options,
+			Meta:         meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the valid field behaviors",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book"),
+							newTestAIPRPC("CreateBook", "CreateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book"),
+						},
+					},
+					&parser.Message{
+						MessageName: "GetBookRequest",
+						MessageBody: []parser.Visitee{
+							newField("string", "name", "REQUIRED"),
+						},
+					},
+					&parser.Message{
+						MessageName: "CreateBookRequest",
+						MessageBody: []parser.Visitee{
+							newField("string", "parent", "REQUIRED"),
+							newField("Book", "book", "[REQUIRED,IMMUTABLE]"),
+							newField("string", "book_id"),
+						},
+					},
+					&parser.Message{
+						MessageName: "UpdateBookRequest",
+						MessageBody: []parser.Visitee{
+							newField("Book", "book", "google.api.REQUIRED"),
+							newField("google.protobuf.FieldMask", "update_mask", "OPTIONAL"),
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("string", "name", "IDENTIFIER"),
+							newField("google.protobuf.Timestamp", "create_time", "OUTPUT_ONLY"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the invalid field behaviors",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("DeleteBook", "DeleteBookRequest", "DeleteBookResponse"),
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse"),
+							newTestAIPRPC("CreateBook", "CreateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"*"}`)),
+						},
+					},
+					&parser.Message{
+						MessageName: "DeleteBookRequest",
+						MessageBody: []parser.Visitee{
+							newField("string", "name"),
+						},
+					},
+					&parser.Message{
+						MessageName: "ListBooksRequest",
+						MessageBody: []parser.Visitee{
+							newField("string", "parent", "REQUIRED", "OUTPUT_ONLY"),
+						},
+					},
+					&parser.Message{
+						MessageName: "CreateBookRequest",
+						MessageBody: []parser.Visitee{
+							newField("string", "parent", "OPTIONAL"),
+							newField("Book", "book", "REQUIRD"),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "AIP_FIELD_BEHAVIOR", `Field "name" of the request of the RPC "DeleteBook" should have the field behavior REQUIRED`),
+				report.Failuref(pos, "AIP_FIELD_BEHAVIOR", `Field "parent" should not be both REQUIRED and OUTPUT_ONLY`),
+				report.Failuref(pos, "AIP_FIELD_BEHAVIOR", `Field "parent" of the request of the RPC "CreateBook" should have the field behavior REQUIRED`),
+				report.Failuref(pos, "AIP_FIELD_BEHAVIOR", `Field "book" has the unknown field behavior "REQUIRD"`),
+				report.Failuref(pos, "AIP_FIELD_BEHAVIOR", `Field "book" of the request of the RPC "CreateBook" should have the field behavior REQUIRED`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPFieldBehaviorRule(nil)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestAIPFieldBehaviorRule_Apply_protoSet(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "library.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "requests.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "book.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFile     file.ProtoFile
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:      "no failures without the linted set",
+			inputFile: setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "requests.proto"),
+		},
+		{
+			name:          "failures for the requests of the RPCs in the other file",
+			inputFile:     setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "requests.proto"),
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/requests.proto",
+						Offset:   111,
+						Line:     8,
+						Column:   3,
+					},
+					"AIP_FIELD_BEHAVIOR",
+					`Field "name" of the request of the RPC "GetBook" should have the field behavior REQUIRED`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/requests.proto",
+						Offset:   223,
+						Line:     13,
+						Column:   3,
+					},
+					"AIP_FIELD_BEHAVIOR",
+					`Field "book" of the request of the RPC "CreateBook" should have the field behavior REQUIRED`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPFieldBehaviorRule(test.inputProtoSet)
+
+			proto, err := test.inputFile.Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// AIPHTTPAnnotationRule verifies that all RPCs have the option (google.api.http) with an HTTP method and a path.
+// See https://google.aip.dev/127.
+type AIPHTTPAnnotationRule struct{}
+
+// NewAIPHTTPAnnotationRule creates a new AIPHTTPAnnotationRule.
+func NewAIPHTTPAnnotationRule() AIPHTTPAnnotationRule {
+	return AIPHTTPAnnotationRule{}
+}
+
+// ID returns the ID of this rule.
+func (r AIPHTTPAnnotationRule) ID() string {
+	return "AIP_HTTP_ANNOTATION"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPHTTPAnnotationRule) Purpose() string {
+	return "Verifies that all RPCs have the option (google.api.http) with an HTTP method and a path."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPHTTPAnnotationRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPHTTPAnnotationRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:  "The gateways like gRPC-gateway transcode only the RPCs with the HTTP bindings. An RPC without one is silently missing from the REST API.",
+		BadExample: `rpc GetBook(GetBookRequest) returns (Book);`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/v1/{name=shelves/*/books/*}"
+  };
+}`,
+		StyleGuideURL: "https://google.aip.dev/127",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPHTTPAnnotationRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &aipHTTPAnnotationVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipHTTPAnnotationVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitRPC checks the rpc.
+func (v *aipHTTPAnnotationVisitor) VisitRPC(rpc *parser.RPC) bool {
+	bindings, option, err := rpcHTTPRules(rpc)
+	switch {
+	case err != nil:
+		v.AddFailuref(option.Meta.Pos, "Option %s of the RPC %q is malformed: %v", httpOptionName, rpc.RPCName, err)
+	case option == nil:
+		v.AddFailuref(rpc.Meta.Pos, "RPC %q should have the option %s", rpc.RPCName, httpOptionName)
+	default:
+		for _, binding := range bindings {
+			if binding.method == "" || binding.path == "" {
+				v.AddFailuref(option.Meta.Pos, "Option %s of the RPC %q should have the HTTP method and the path", httpOptionName, rpc.RPCName)
+				break
+			}
+		}
+	}
+	return false
+}
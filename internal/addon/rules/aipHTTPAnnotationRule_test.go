@@ -0,0 +1,151 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func newTestAIPRPC(name string, request string, response string, options ...*parser.Option) *parser.RPC {
+	return &parser.RPC{
+		RPCName: name,
+		RPCRequest: &parser.RPCRequest{
+			MessageType: request,
+		},
+		RPCResponse: &parser.RPCResponse{
+			MessageType: response,
+		},
+		Options: options,
+		Meta: meta.Meta{
+			Pos: meta.Position{
+				Filename: "example.proto",
+				Offset:   100,
+				Line:     5,
+				Column:   10,
+			},
+		},
+	}
+}
+
+func newTestAIPOption(name string, constant string) *parser.Option {
+	return &parser.Option{
+		OptionName: name,
+		Constant:   constant,
+		Meta: meta.Meta{
+			Pos: meta.Position{
+				Filename: "example.proto",
+				Offset:   200,
+				Line:     10,
+				Column:   20,
+			},
+		},
+	}
+}
+
+func TestAIPHTTPAnnotationRule_Apply(t *testing.T) {
+	rpcPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	optionPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   200,
+		Line:     10,
+		Column:   20,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto without RPCs",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with the HTTP bindings",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC(
+								"GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{name=shelves/*/books/*}"`+"\n"+`additional_bindings{get:"/v1/{name=books/*}"}}`),
+							),
+							newTestAIPRPC(
+								"ArchiveBook", "ArchiveBookRequest", "ArchiveBookResponse",
+								newTestAIPOption("(google.api.http).post", `"/v1/{name=books/*}:archive"`),
+								newTestAIPOption("(google.api.http).body", `"*"`),
+							),
+							newTestAIPRPC(
+								"HeadBook", "HeadBookRequest", "HeadBookResponse",
+								newTestAIPOption("(google.api.http)", `{custom:{kind:"HEAD",path:"/v1/{name=books/*}"}}`),
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto without the HTTP bindings",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC(
+								"GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("deprecated", "true"),
+							),
+							newTestAIPRPC(
+								"ListBooks", "ListBooksRequest", "ListBooksResponse",
+								newTestAIPOption("(google.api.http)", `{body:"*"}`),
+							),
+							newTestAIPRPC(
+								"DeleteBook", "DeleteBookRequest", "DeleteBookResponse",
+								newTestAIPOption("(google.api.http)", `{delete:"/v1/{name=books/*}"`+"\n"+`additional_bindings{body:"*"}}`),
+							),
+							newTestAIPRPC(
+								"UpdateBook", "UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:}`),
+							),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(rpcPos, "AIP_HTTP_ANNOTATION", `RPC "GetBook" should have the option (google.api.http)`),
+				report.Failuref(optionPos, "AIP_HTTP_ANNOTATION", `Option (google.api.http) of the RPC "ListBooks" should have the HTTP method and the path`),
+				report.Failuref(optionPos, "AIP_HTTP_ANNOTATION", `Option (google.api.http) of the RPC "DeleteBook" should have the HTTP method and the path`),
+				report.Failuref(optionPos, "AIP_HTTP_ANNOTATION", `Option (google.api.http) of the RPC "UpdateBook" is malformed: found "}", but want a value of "patch"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPHTTPAnnotationRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
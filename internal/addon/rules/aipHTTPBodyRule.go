@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// AIPHTTPBodyRule verifies the body of the option (google.api.http).
+//
+//   - GET and DELETE have no body.
+//   - Create and Update have the body of the resource field, not "*".
+//   - The body is a field of the request.
+//
+// The request is looked up from the proto and the linted set. The body is not verified against an unknown request.
+type AIPHTTPBodyRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewAIPHTTPBodyRule creates a new AIPHTTPBodyRule.
+// The requests are looked up from protoSet as well.
+func NewAIPHTTPBodyRule(
+	protoSet *file.ParsedProtoSet,
+) AIPHTTPBodyRule {
+	return AIPHTTPBodyRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r AIPHTTPBodyRule) ID() string {
+	return "AIP_HTTP_BODY"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPHTTPBodyRule) Purpose() string {
+	return "Verifies the body of the option (google.api.http)."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPHTTPBodyRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPHTTPBodyRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: `GET and DELETE requests with a body are dropped by many proxies. The body "*" of Create and Update maps every request field, including the ones in the path, into the body.`,
+		BadExample: `rpc CreateBook(CreateBookRequest) returns (Book) {
+  option (google.api.http) = {
+    post: "/v1/{parent=shelves/*}/books"
+    body: "*"
+  };
+}`,
+		GoodExample: `rpc CreateBook(CreateBookRequest) returns (Book) {
+  option (google.api.http) = {
+    post: "/v1/{parent=shelves/*}/books"
+    body: "book"
+  };
+}`,
+		StyleGuideURL: "https://google.aip.dev/133",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPHTTPBodyRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &aipHTTPBodyVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		messages:       newMessageIndex(proto, r.protoSet),
+		pkg:            protoPackageName(proto),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipHTTPBodyVisitor struct {
+	*visitor.BaseAddVisitor
+	messages messageIndex
+	pkg      string
+}
+
+// VisitRPC checks the rpc.
+func (v *aipHTTPBodyVisitor) VisitRPC(rpc *parser.RPC) bool {
+	bindings, option, err := rpcHTTPRules(rpc)
+	if err != nil || option == nil {
+		return false
+	}
+	request, scope := v.messages.resolve(rpc.RPCRequest.MessageType, v.pkg)
+
+	method := standardMethod(rpc.RPCName)
+	for _, binding := range bindings {
+		switch {
+		case (binding.method == "get" || binding.method == "delete") && binding.body != "":
+			v.AddFailuref(option.Meta.Pos, "RPC %q should not have the HTTP body with %s", rpc.RPCName, strings.ToUpper(binding.method))
+		case (method == standardMethodCreate || method == standardMethodUpdate) && binding.body == "":
+			v.AddFailuref(option.Meta.Pos, "RPC %q should have the HTTP body of the resource field", rpc.RPCName)
+		case (method == standardMethodCreate || method == standardMethodUpdate) && binding.body == "*":
+			v.AddFailuref(option.Meta.Pos, `RPC %q should have the HTTP body of the resource field instead of "*"`, rpc.RPCName)
+		case binding.body != "" && binding.body != "*" && request != nil:
+			if _, ok := v.messages.fieldByPath(request, scope, binding.body); !ok {
+				v.AddFailuref(option.Meta.Pos, "HTTP body %q of the RPC %q should be a field of %q", binding.body, rpc.RPCName, scope)
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestAIPHTTPBodyRule_Apply(t *testing.T) {
+	optionPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   200,
+		Line:     10,
+		Column:   20,
+	}
+
+	requests := []parser.Visitee{
+		&parser.Package{
+			Name: "acme.library.v1",
+		},
+		&parser.Message{
+			MessageName: "CreateBookRequest",
+			MessageBody: []parser.Visitee{
+				&parser.Field{Type: "string", FieldName: "parent"},
+				&parser.Field{Type: "Book", FieldName: "book"},
+			},
+		},
+		&parser.Message{
+			MessageName: "UpdateBookRequest",
+			MessageBody: []parser.Visitee{
+				&parser.Oneof{
+					OneofFields: []*parser.OneofField{
+						{Type: "Book", FieldName: "book"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the valid bodies",
+			inputProto: &parser.Proto{
+				ProtoBody: append([]parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{name=books/*}"}`)),
+							newTestAIPRPC("CreateBook", "CreateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("UpdateBook", ".acme.library.v1.UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.name=books/*}"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("ArchiveBook", "ArchiveBookRequest", "ArchiveBookResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{name=books/*}:archive"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("SortBooks", "SortBooksRequest", "SortBooksResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/books:sort"`+"\n"+`body:"unknown_request"}`)),
+						},
+					},
+				}, requests...),
+			},
+		},
+		{
+			name: "failures for proto with the invalid bodies",
+			inputProto: &parser.Proto{
+				ProtoBody: append([]parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{name=books/*}"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("DeleteBook", "DeleteBookRequest", "DeleteBookResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{name=books/*}:delete"`+"\n"+`additional_bindings{delete:"/v1/{name=books/*}"`+"\n"+`body:"name"}}`)),
+							newTestAIPRPC("CreateBook", "CreateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.name=books/*}"}`)),
+							newTestAIPRPC("CreateShelf", "CreateBookRequest", "Shelf",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/shelves"`+"\n"+`body:"shelf"}`)),
+						},
+					},
+				}, requests...),
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(optionPos, "AIP_HTTP_BODY", `RPC "GetBook" should not have the HTTP body with GET`),
+				report.Failuref(optionPos, "AIP_HTTP_BODY", `RPC "DeleteBook" should not have the HTTP body with DELETE`),
+				report.Failuref(optionPos, "AIP_HTTP_BODY", `RPC "CreateBook" should have the HTTP body of the resource field instead of "*"`),
+				report.Failuref(optionPos, "AIP_HTTP_BODY", `RPC "UpdateBook" should have the HTTP body of the resource field`),
+				report.Failuref(optionPos, "AIP_HTTP_BODY", `HTTP body "shelf" of the RPC "CreateShelf" should be a field of "acme.library.v1.CreateBookRequest"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPHTTPBodyRule(nil)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
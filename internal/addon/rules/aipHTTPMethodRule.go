@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// AIPHTTPMethodRule verifies that the standard methods use their HTTP methods.
+// Get and List use GET, Create uses POST, Update uses PATCH and Delete uses DELETE.
+// See https://google.aip.dev/131 through https://google.aip.dev/135.
+type AIPHTTPMethodRule struct{}
+
+// NewAIPHTTPMethodRule creates a new AIPHTTPMethodRule.
+func NewAIPHTTPMethodRule() AIPHTTPMethodRule {
+	return AIPHTTPMethodRule{}
+}
+
+// ID returns the ID of this rule.
+func (r AIPHTTPMethodRule) ID() string {
+	return "AIP_HTTP_METHOD"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPHTTPMethodRule) Purpose() string {
+	return "Verifies that the standard methods like GetBook use their HTTP methods like GET."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPHTTPMethodRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPHTTPMethodRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The REST clients and the caches expect the semantics of the HTTP methods. A Get bound to POST is neither cacheable nor safe to retry.",
+		BadExample: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    post: "/v1/{name=shelves/*/books/*}"
+  };
+}`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/v1/{name=shelves/*/books/*}"
+  };
+}`,
+		StyleGuideURL: "https://google.aip.dev/131",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPHTTPMethodRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &aipHTTPMethodVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipHTTPMethodVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitRPC checks the rpc.
+func (v *aipHTTPMethodVisitor) VisitRPC(rpc *parser.RPC) bool {
+	method := standardMethod(rpc.RPCName)
+	if method == "" {
+		return false
+	}
+	bindings, option, err := rpcHTTPRules(rpc)
+	if err != nil || option == nil {
+		return false
+	}
+
+	want := standardHTTPMethods[method]
+	for _, binding := range bindings {
+		if binding.method != "" && binding.method != want {
+			v.AddFailuref(
+				option.Meta.Pos,
+				"RPC %q should use the HTTP method %s, but it uses %s",
+				rpc.RPCName,
+				strings.ToUpper(want),
+				strings.ToUpper(binding.method),
+			)
+		}
+	}
+	return false
+}
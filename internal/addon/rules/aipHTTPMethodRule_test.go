@@ -0,0 +1,103 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestAIPHTTPMethodRule_Apply(t *testing.T) {
+	optionPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   200,
+		Line:     10,
+		Column:   20,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the standard HTTP methods",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{name=books/*}"}`)),
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{parent=shelves/*}/books"}`)),
+							newTestAIPRPC("CreateBook", "CreateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.name=books/*}"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("DeleteBook", "DeleteBookRequest", "DeleteBookResponse",
+								newTestAIPOption("(google.api.http).delete", `"/v1/{name=books/*}"`)),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with the custom methods and without the HTTP bindings",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("BatchGetBooks", "BatchGetBooksRequest", "BatchGetBooksResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/books:batchGet"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("Getaway", "GetawayRequest", "GetawayResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/getaway"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the wrong HTTP methods",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse",
+								newTestAIPOption("(google.api.http)", `{post:"/v1/{parent=shelves/*}/books"`+"\n"+`body:"*"}`)),
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.name=books/*}"`+"\n"+`body:"book"`+"\n"+`additional_bindings{put:"/v1/{book.name=shelves/*/books/*}"`+"\n"+`body:"book"}}`)),
+							newTestAIPRPC("DeleteBook", "DeleteBookRequest", "DeleteBookResponse",
+								newTestAIPOption("(google.api.http)", `{custom:{kind:"PURGE",path:"/v1/{name=books/*}"}}`)),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(optionPos, "AIP_HTTP_METHOD", `RPC "ListBooks" should use the HTTP method GET, but it uses POST`),
+				report.Failuref(optionPos, "AIP_HTTP_METHOD", `RPC "UpdateBook" should use the HTTP method PATCH, but it uses PUT`),
+				report.Failuref(optionPos, "AIP_HTTP_METHOD", `RPC "DeleteBook" should use the HTTP method DELETE, but it uses PURGE`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPHTTPMethodRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
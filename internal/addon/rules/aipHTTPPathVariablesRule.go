@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// AIPHTTPPathVariablesRule verifies that the variables in the paths of the option (google.api.http) are the fields of the request.
+// The request is looked up from the proto and the linted set. The paths are not verified against an unknown request.
+type AIPHTTPPathVariablesRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewAIPHTTPPathVariablesRule creates a new AIPHTTPPathVariablesRule.
+// The requests are looked up from protoSet as well.
+func NewAIPHTTPPathVariablesRule(
+	protoSet *file.ParsedProtoSet,
+) AIPHTTPPathVariablesRule {
+	return AIPHTTPPathVariablesRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r AIPHTTPPathVariablesRule) ID() string {
+	return "AIP_HTTP_PATH_VARIABLES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPHTTPPathVariablesRule) Purpose() string {
+	return "Verifies that the variables in the paths of the option (google.api.http) are the fields of the request."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPHTTPPathVariablesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPHTTPPathVariablesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The gateway fills the request fields from the path variables. A variable which isn't a field fails only at run time.",
+		BadExample: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/v1/{book_name=shelves/*/books/*}"
+  };
+}
+
+message GetBookRequest {
+  string name = 1;
+}`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = {
+    get: "/v1/{name=shelves/*/books/*}"
+  };
+}
+
+message GetBookRequest {
+  string name = 1;
+}`,
+		StyleGuideURL: "https://google.aip.dev/127",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPHTTPPathVariablesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &aipHTTPPathVariablesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		messages:       newMessageIndex(proto, r.protoSet),
+		pkg:            protoPackageName(proto),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipHTTPPathVariablesVisitor struct {
+	*visitor.BaseAddVisitor
+	messages messageIndex
+	pkg      string
+}
+
+// VisitRPC checks the rpc.
+func (v *aipHTTPPathVariablesVisitor) VisitRPC(rpc *parser.RPC) bool {
+	bindings, option, err := rpcHTTPRules(rpc)
+	if err != nil || option == nil {
+		return false
+	}
+	request, scope := v.messages.resolve(rpc.RPCRequest.MessageType, v.pkg)
+	if request == nil {
+		return false
+	}
+
+	for _, binding := range bindings {
+		for _, variable := range pathVariables(binding.path) {
+			if _, ok := v.messages.fieldByPath(request, scope, variable); !ok {
+				v.AddFailuref(option.Meta.Pos, "Path variable %q of the RPC %q should be a field of %q", variable, rpc.RPCName, scope)
+			}
+		}
+	}
+	return false
+}
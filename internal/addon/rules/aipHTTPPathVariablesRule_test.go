@@ -0,0 +1,113 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestAIPHTTPPathVariablesRule_Apply(t *testing.T) {
+	optionPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   200,
+		Line:     10,
+		Column:   20,
+	}
+
+	requests := []parser.Visitee{
+		&parser.Package{
+			Name: "acme.library.v1",
+		},
+		&parser.Message{
+			MessageName: "GetBookRequest",
+			MessageBody: []parser.Visitee{
+				&parser.Field{Type: "string", FieldName: "name"},
+			},
+		},
+		&parser.Message{
+			MessageName: "UpdateBookRequest",
+			MessageBody: []parser.Visitee{
+				&parser.Field{Type: "Book", FieldName: "book"},
+				&parser.MapField{KeyType: "string", Type: "string", MapName: "labels"},
+			},
+		},
+		&parser.Message{
+			MessageName: "Book",
+			MessageBody: []parser.Visitee{
+				&parser.Field{Type: "string", FieldName: "name"},
+				&parser.Field{Type: "Book.Author", FieldName: "author"},
+				&parser.Message{
+					MessageName: "Author",
+					MessageBody: []parser.Visitee{
+						&parser.Field{Type: "string", FieldName: "id"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the path variables of the request fields",
+			inputProto: &parser.Proto{
+				ProtoBody: append([]parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{name=shelves/*/books/*}"`+"\n"+`additional_bindings{get:"/v1/{name}"}}`)),
+							newTestAIPRPC("UpdateBook", "acme.library.v1.UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.name=books/*}/authors/{book.author.id}"`+"\n"+`body:"book"}`)),
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{parent=shelves/*}/books"}`)),
+						},
+					},
+				}, requests...),
+			},
+		},
+		{
+			name: "failures for proto with the path variables of the missing fields",
+			inputProto: &parser.Proto{
+				ProtoBody: append([]parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{get:"/v1/{book_name=shelves/*/books/*}"}`)),
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book",
+								newTestAIPOption("(google.api.http)", `{patch:"/v1/{book.id=books/*}"`+"\n"+`body:"book"`+"\n"+`additional_bindings{patch:"/v1/{labels.key}"}}`)),
+						},
+					},
+				}, requests...),
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(optionPos, "AIP_HTTP_PATH_VARIABLES", `Path variable "book_name" of the RPC "GetBook" should be a field of "acme.library.v1.GetBookRequest"`),
+				report.Failuref(optionPos, "AIP_HTTP_PATH_VARIABLES", `Path variable "book.id" of the RPC "UpdateBook" should be a field of "acme.library.v1.UpdateBookRequest"`),
+				report.Failuref(optionPos, "AIP_HTTP_PATH_VARIABLES", `Path variable "labels.key" of the RPC "UpdateBook" should be a field of "acme.library.v1.UpdateBookRequest"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPHTTPPathVariablesRule(nil)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
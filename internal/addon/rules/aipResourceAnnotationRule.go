@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// AIPResourceAnnotationRule verifies that the resources have the option (google.api.resource) with the type and the pattern.
+// The resources are the responses of the standard methods Get, Create and Update in the proto and the linted set.
+// See https://google.aip.dev/123.
+type AIPResourceAnnotationRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewAIPResourceAnnotationRule creates a new AIPResourceAnnotationRule.
+// The standard methods are looked up from protoSet as well.
+func NewAIPResourceAnnotationRule(
+	protoSet *file.ParsedProtoSet,
+) AIPResourceAnnotationRule {
+	return AIPResourceAnnotationRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r AIPResourceAnnotationRule) ID() string {
+	return "AIP_RESOURCE_ANNOTATION"
+}
+
+// Purpose returns the purpose of this rule.
+func (r AIPResourceAnnotationRule) Purpose() string {
+	return "Verifies that the resources have the option (google.api.resource) with the type and the pattern."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r AIPResourceAnnotationRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r AIPResourceAnnotationRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The client generators and the IAM policies identify the resources by their types and patterns. A resource without the annotation gets no resource name helpers.",
+		BadExample: `rpc GetBook(GetBookRequest) returns (Book);
+
+message Book {
+  string name = 1;
+}`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (Book);
+
+message Book {
+  option (google.api.resource) = {
+    type: "library.googleapis.com/Book"
+    pattern: "shelves/{shelf}/books/{book}"
+  };
+  string name = 1;
+}`,
+		StyleGuideURL: "https://google.aip.dev/123",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r AIPResourceAnnotationRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	messages := newMessageIndex(proto, r.protoSet)
+	v := &aipResourceAnnotationVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		resources:      make(map[*parser.Message]string),
+	}
+	forEachRPC(proto, r.protoSet, func(rpc *parser.RPC, pkg string) {
+		switch standardMethod(rpc.RPCName) {
+		case standardMethodGet, standardMethodCreate, standardMethodUpdate:
+		default:
+			return
+		}
+		resource, _ := messages.resolve(rpc.RPCResponse.MessageType, pkg)
+		if _, ok := v.resources[resource]; resource != nil && !ok {
+			v.resources[resource] = rpc.RPCName
+		}
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type aipResourceAnnotationVisitor struct {
+	*visitor.BaseAddVisitor
+	resources map[*parser.Message]string
+}
+
+// VisitMessage checks the message.
+func (v *aipResourceAnnotationVisitor) VisitMessage(message *parser.Message) bool {
+	var option *parser.Option
+	for _, element := range message.MessageBody {
+		if o, ok := element.(*parser.Option); ok && o.OptionName == resourceOptionName {
+			option = o
+		}
+	}
+
+	if option == nil {
+		if rpcName, ok := v.resources[message]; ok {
+			v.AddFailuref(message.Meta.Pos, "Message %q is the resource of the RPC %q and should have the option %s", message.MessageName, rpcName, resourceOptionName)
+		}
+		return true
+	}
+
+	fields, err := parseTextMessage(option.Constant)
+	if err != nil {
+		v.AddFailuref(option.Meta.Pos, "Option %s of the message %q is malformed: %v", resourceOptionName, message.MessageName, err)
+		return true
+	}
+	values := make(map[string]string)
+	for _, field := range fields {
+		values[field.name] = field.value
+	}
+	for _, name := range []string{"type", "pattern"} {
+		if values[name] == "" {
+			v.AddFailuref(option.Meta.Pos, "Option %s of the message %q should have the %s", resourceOptionName, message.MessageName, name)
+		}
+	}
+	if typ := values["type"]; typ != "" && !isResourceType(typ, message.MessageName) {
+		v.AddFailuref(option.Meta.Pos, "Resource type %q of the message %q should be in the form of {service}/%s", typ, message.MessageName, message.MessageName)
+	}
+	return true
+}
+
+// isResourceType decides whether or not the type is in the form of {service}/{kind}, e.g. library.googleapis.com/Book.
+func isResourceType(typ string, kind string) bool {
+	segments := strings.Split(typ, "/")
+	return len(segments) == 2 && segments[0] != "" && segments[1] == kind
+}
@@ -0,0 +1,171 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestAIPResourceAnnotationRule_Apply(t *testing.T) {
+	messagePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   300,
+		Line:     15,
+		Column:   1,
+	}
+	optionPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   200,
+		Line:     10,
+		Column:   20,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the annotated resources",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book"),
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse"),
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newTestAIPOption("(google.api.resource)", `{type:"library.googleapis.com/Book"`+"\n"+`pattern:"shelves/{shelf}/books/{book}"}`),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListBooksResponse",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the resources without the valid annotations",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("GetBook", "GetBookRequest", "Book"),
+							newTestAIPRPC("CreateShelf", "CreateShelfRequest", "Library.Shelf"),
+							newTestAIPRPC("UpdateShelf", "UpdateShelfRequest", "Library.Shelf"),
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "Library",
+						MessageBody: []parser.Visitee{
+							&parser.Message{
+								MessageName: "Shelf",
+								MessageBody: []parser.Visitee{
+									newTestAIPOption("(google.api.resource)", `{type:"Shelf"}`),
+								},
+								Meta: meta.Meta{Pos: messagePos},
+							},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(messagePos, "AIP_RESOURCE_ANNOTATION", `Message "Book" is the resource of the RPC "GetBook" and should have the option (google.api.resource)`),
+				report.Failuref(optionPos, "AIP_RESOURCE_ANNOTATION", `Option (google.api.resource) of the message "Shelf" should have the pattern`),
+				report.Failuref(optionPos, "AIP_RESOURCE_ANNOTATION", `Resource type "Shelf" of the message "Shelf" should be in the form of {service}/Shelf`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPResourceAnnotationRule(nil)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestAIPResourceAnnotationRule_Apply_protoSet(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "library.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "requests.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "book.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFile     file.ProtoFile
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:      "no failures without the linted set",
+			inputFile: setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "book.proto"),
+		},
+		{
+			name:          "failures for the resource of the RPC in the other file",
+			inputFile:     setting_test.TestProtoFile("acme/library/v1", "rules", "aip", "book.proto"),
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/book.proto",
+						Offset:   46,
+						Line:     5,
+						Column:   1,
+					},
+					"AIP_RESOURCE_ANNOTATION",
+					`Message "Book" is the resource of the RPC "GetBook" and should have the option (google.api.resource)`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewAIPResourceAnnotationRule(test.inputProtoSet)
+
+			proto, err := test.inputFile.Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
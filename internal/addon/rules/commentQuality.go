@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// disableCommand matches the comments to disable or enable the rules, which are not the documentation.
+var disableCommand = regexp.MustCompile(`protolint:(disable|enable)`)
+
+// commentText returns the lines of the comments joined with the newlines, leaving out the comments to disable the rules.
+// The lines are trimmed of the spaces and the leading asterisks of the C-style comments.
+func commentText(comments []*parser.Comment) string {
+	var lines []string
+	for _, comment := range comments {
+		if disableCommand.MatchString(comment.Raw) {
+			continue
+		}
+		for _, line := range comment.Lines() {
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*")))
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// commentQualityVisitor checks the comments of the messages, the fields, the enums, the services and the RPCs.
+// The elements without comments are left to the rules like MESSAGES_HAVE_COMMENT.
+type commentQualityVisitor struct {
+	*visitor.BaseAddVisitor
+	// check returns the problems of the comment text of the element, e.g. "should end with a period".
+	check func(text string, name string) []string
+}
+
+func newCommentQualityVisitor(
+	ruleID string,
+	check func(text string, name string) []string,
+) *commentQualityVisitor {
+	return &commentQualityVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(ruleID),
+		check:          check,
+	}
+}
+
+func (v *commentQualityVisitor) checkComments(kind string, name string, comments []*parser.Comment, pos meta.Position) {
+	text := commentText(comments)
+	if text == "" {
+		return
+	}
+	for _, problem := range v.check(text, name) {
+		v.AddFailuref(pos, "Comment of the %s %q %s", kind, name, problem)
+	}
+}
+
+// VisitMessage checks the message.
+func (v *commentQualityVisitor) VisitMessage(message *parser.Message) bool {
+	v.checkComments("message", message.MessageName, message.Comments, message.Meta.Pos)
+	return true
+}
+
+// VisitField checks the field.
+func (v *commentQualityVisitor) VisitField(field *parser.Field) bool {
+	v.checkComments("field", field.FieldName, field.Comments, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *commentQualityVisitor) VisitMapField(field *parser.MapField) bool {
+	v.checkComments("field", field.MapName, field.Comments, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *commentQualityVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.checkComments("field", field.FieldName, field.Comments, field.Meta.Pos)
+	return false
+}
+
+// VisitEnum checks the enum.
+func (v *commentQualityVisitor) VisitEnum(enum *parser.Enum) bool {
+	v.checkComments("enum", enum.EnumName, enum.Comments, enum.Meta.Pos)
+	return false
+}
+
+// VisitService checks the service.
+func (v *commentQualityVisitor) VisitService(service *parser.Service) bool {
+	v.checkComments("service", service.ServiceName, service.Comments, service.Meta.Pos)
+	return true
+}
+
+// VisitRPC checks the rpc.
+func (v *commentQualityVisitor) VisitRPC(rpc *parser.RPC) bool {
+	v.checkComments("RPC", rpc.RPCName, rpc.Comments, rpc.Meta.Pos)
+	return false
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// protoCodeLine matches the lines which look like the proto declarations, e.g. `string title = 1;` and `message Book {`.
+var protoCodeLine = regexp.MustCompile(strings.Join([]string{
+	`^((repeated|optional|required)\s+)?[A-Za-z_][\w.]*\s+[A-Za-z_]\w*\s*=\s*\d+\s*(\[.*\])?\s*;$`,
+	`^map\s*<.*>\s*[A-Za-z_]\w*\s*=\s*\d+.*;$`,
+	`^(message|enum|service|oneof|extend)\s+[A-Za-z_][\w.]*\s*{$`,
+	`^rpc\s+[A-Za-z_]\w*\s*\(.*\)\s*returns\s*\(.*\).*[;{]$`,
+	`^(option|import|package|syntax|reserved)\b.*;$`,
+	`^[A-Z][A-Z0-9_]*\s*=\s*-?\d+\s*(\[.*\])?\s*;$`,
+}, "|"))
+
+// CommentsAvoidCommentedOutCodeRule verifies that the comments of the messages, fields, enums, services and RPCs don't have commented-out proto code.
+type CommentsAvoidCommentedOutCodeRule struct{}
+
+// NewCommentsAvoidCommentedOutCodeRule creates a new CommentsAvoidCommentedOutCodeRule.
+func NewCommentsAvoidCommentedOutCodeRule() CommentsAvoidCommentedOutCodeRule {
+	return CommentsAvoidCommentedOutCodeRule{}
+}
+
+// ID returns the ID of this rule.
+func (r CommentsAvoidCommentedOutCodeRule) ID() string {
+	return "COMMENTS_AVOID_COMMENTED_OUT_CODE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r CommentsAvoidCommentedOutCodeRule) Purpose() string {
+	return "Verifies that the comments don't have commented-out proto code."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r CommentsAvoidCommentedOutCodeRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r CommentsAvoidCommentedOutCodeRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The commented-out code ends up published in the API reference and confuses the readers. The version control keeps the old code, and the deleted fields should be reserved.",
+		BadExample: `message Book {
+  // string isbn = 1;
+  string title = 2;
+}`,
+		GoodExample: `message Book {
+  reserved 1;
+  reserved "isbn";
+  string title = 2;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r CommentsAvoidCommentedOutCodeRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := newCommentQualityVisitor(r.ID(), func(text string, _ string) []string {
+		var problems []string
+		for _, line := range strings.Split(text, "\n") {
+			if protoCodeLine.MatchString(line) {
+				problems = append(problems, fmt.Sprintf("should not have the commented-out code %q", line))
+			}
+		}
+		return problems
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
@@ -0,0 +1,134 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestCommentsAvoidCommentedOutCodeRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with comments of prose",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book in the library.",
+							},
+							{
+								Raw: "// The default of pages = 100 is applied;",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "isbn",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Set isbn = 0 when unknown.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with commented-out code",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// message Book {",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "title",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// The title of the book.",
+									},
+									{
+										Raw: "// repeated string old_titles = 3 [deprecated = true];",
+									},
+									{
+										Raw: "// map<string, string> labels = 4;",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						Comments: []*parser.Comment{
+							{
+								Raw: "/*\n * GENRE_FICTION = 1;\n */",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Service{
+						ServiceName: "LibraryService",
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_AVOID_COMMENTED_OUT_CODE", `Comment of the message "Book" should not have the commented-out code "message Book {"`),
+				report.Failuref(pos, "COMMENTS_AVOID_COMMENTED_OUT_CODE", `Comment of the field "title" should not have the commented-out code "repeated string old_titles = 3 [deprecated = true];"`),
+				report.Failuref(pos, "COMMENTS_AVOID_COMMENTED_OUT_CODE", `Comment of the field "title" should not have the commented-out code "map<string, string> labels = 4;"`),
+				report.Failuref(pos, "COMMENTS_AVOID_COMMENTED_OUT_CODE", `Comment of the enum "Genre" should not have the commented-out code "GENRE_FICTION = 1;"`),
+				report.Failuref(pos, "COMMENTS_AVOID_COMMENTED_OUT_CODE", `Comment of the RPC "GetBook" should not have the commented-out code "rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewCommentsAvoidCommentedOutCodeRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
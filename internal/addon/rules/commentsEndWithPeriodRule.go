@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// CommentsEndWithPeriodRule verifies that the comments of the messages, fields, enums, services and RPCs end with a period.
+type CommentsEndWithPeriodRule struct{}
+
+// NewCommentsEndWithPeriodRule creates a new CommentsEndWithPeriodRule.
+func NewCommentsEndWithPeriodRule() CommentsEndWithPeriodRule {
+	return CommentsEndWithPeriodRule{}
+}
+
+// ID returns the ID of this rule.
+func (r CommentsEndWithPeriodRule) ID() string {
+	return "COMMENTS_END_WITH_PERIOD"
+}
+
+// Purpose returns the purpose of this rule.
+func (r CommentsEndWithPeriodRule) Purpose() string {
+	return "Verifies that the comments of the messages, fields, enums, services and RPCs end with a period."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r CommentsEndWithPeriodRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r CommentsEndWithPeriodRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The comments are published as the API reference. Writing them in full sentences makes the reference read consistently.",
+		BadExample: `message Book {
+  // The title of the book
+  string title = 1;
+}`,
+		GoodExample: `message Book {
+  // The title of the book.
+  string title = 1;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r CommentsEndWithPeriodRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := newCommentQualityVisitor(r.ID(), func(text string, _ string) []string {
+		if !strings.HasSuffix(text, ".") {
+			return []string{"should end with a period"}
+		}
+		return nil
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
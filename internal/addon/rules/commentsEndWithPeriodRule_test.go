@@ -0,0 +1,130 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestCommentsEndWithPeriodRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with comments ending with a period",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book",
+							},
+							{
+								Raw: "// in the library.  ",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								MapName: "labels",
+								Comments: []*parser.Comment{
+									{
+										Raw: "/**\n * The labels of the book.\n */",
+									},
+								},
+							},
+							&parser.Field{
+								FieldName: "isbn",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with comments not ending with a period",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book in the library",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName: "url",
+										Comments: []*parser.Comment{
+											{
+												Raw: "// The URL of the book.",
+											},
+											{
+												Raw: "// protolint:disable:this FIELD_NAMES_LOWER_SNAKE_CASE",
+											},
+										},
+									},
+									{
+										FieldName: "content",
+										Comments: []*parser.Comment{
+											{
+												Raw: "// The content:",
+											},
+										},
+										Meta: meta.Meta{Pos: pos},
+									},
+								},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Service{
+						ServiceName: "LibraryService",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// The service of the library!",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_END_WITH_PERIOD", `Comment of the message "Book" should end with a period`),
+				report.Failuref(pos, "COMMENTS_END_WITH_PERIOD", `Comment of the field "content" should end with a period`),
+				report.Failuref(pos, "COMMENTS_END_WITH_PERIOD", `Comment of the service "LibraryService" should end with a period`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewCommentsEndWithPeriodRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// commentPatternNamePlaceholder is replaced with the name of the element in the pattern.
+const commentPatternNamePlaceholder = "{name}"
+
+// CommentsMatchPatternRule verifies that the comments of the messages, fields, enums, services and RPCs match the pattern.
+type CommentsMatchPatternRule struct {
+	pattern string
+}
+
+// NewCommentsMatchPatternRule creates a new CommentsMatchPatternRule.
+// The comments are not verified if pattern is empty.
+func NewCommentsMatchPatternRule(
+	pattern string,
+) CommentsMatchPatternRule {
+	return CommentsMatchPatternRule{
+		pattern: pattern,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r CommentsMatchPatternRule) ID() string {
+	return "COMMENTS_MATCH_PATTERN"
+}
+
+// Purpose returns the purpose of this rule.
+func (r CommentsMatchPatternRule) Purpose() string {
+	return "Verifies that the comments of the messages, fields, enums, services and RPCs match the pattern."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r CommentsMatchPatternRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r CommentsMatchPatternRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A team convention like starting with the name of the element or citing the units keeps the comments uniform across the API.",
+		BadExample: `message Book {
+  // The title of the book.
+  string title = 1;
+}`,
+		GoodExample: `message Book {
+  // title is the title of the book.
+  string title = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "pattern",
+				Description: `The regular expression which the comments must match, e.g. "^{name} ". {name} is replaced with the name of the element. The default is empty, which verifies nothing.`,
+				Kind:        rule.OptionKindRegexp,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r CommentsMatchPatternRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	if r.pattern == "" {
+		return nil, nil
+	}
+	if _, err := regexp.Compile(r.pattern); err != nil {
+		return nil, err
+	}
+
+	v := newCommentQualityVisitor(r.ID(), func(text string, name string) []string {
+		pattern := strings.Replace(r.pattern, commentPatternNamePlaceholder, regexp.QuoteMeta(name), -1)
+		if !regexp.MustCompile(pattern).MatchString(text) {
+			return []string{fmt.Sprintf("should match `%s`", pattern)}
+		}
+		return nil
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
@@ -0,0 +1,101 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestCommentsMatchPatternRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	inputProto := &parser.Proto{
+		ProtoBody: []parser.Visitee{
+			&parser.Message{
+				MessageName: "Book",
+				Comments: []*parser.Comment{
+					{
+						Raw: "// Book is a book.",
+					},
+				},
+				MessageBody: []parser.Visitee{
+					&parser.Field{
+						FieldName: "title",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// The title of the book.",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Field{
+						FieldName: "isbn",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		inputPattern string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+		wantExistErr bool
+	}{
+		{
+			name:       "no failures without the pattern",
+			inputProto: inputProto,
+		},
+		{
+			name:         "no failures for proto with comments matching the pattern",
+			inputPattern: `\.$`,
+			inputProto:   inputProto,
+		},
+		{
+			name:         "failures for proto with comments not matching the pattern with the name",
+			inputPattern: `^{name} `,
+			inputProto:   inputProto,
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_MATCH_PATTERN", "Comment of the field \"title\" should match `^title `"),
+			},
+		},
+		{
+			name:         "an error for the invalid pattern",
+			inputPattern: `^({name}`,
+			inputProto:   inputProto,
+			wantExistErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewCommentsMatchPatternRule(test.inputPattern)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
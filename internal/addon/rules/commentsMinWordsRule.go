@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultCommentsMinWords = 3
+
+// CommentsMinWordsRule verifies that the comments of the messages, fields, enums, services and RPCs have the minimum number of words.
+type CommentsMinWordsRule struct {
+	minWords int
+}
+
+// NewCommentsMinWordsRule creates a new CommentsMinWordsRule.
+// The default is used if minWords is not positive.
+func NewCommentsMinWordsRule(
+	minWords int,
+) CommentsMinWordsRule {
+	if minWords <= 0 {
+		minWords = defaultCommentsMinWords
+	}
+	return CommentsMinWordsRule{
+		minWords: minWords,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r CommentsMinWordsRule) ID() string {
+	return "COMMENTS_MIN_WORDS"
+}
+
+// Purpose returns the purpose of this rule.
+func (r CommentsMinWordsRule) Purpose() string {
+	return "Verifies that the comments of the messages, fields, enums, services and RPCs have the minimum number of words."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r CommentsMinWordsRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r CommentsMinWordsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment like `// Title.` only satisfies the presence check. A few more words are needed to tell the meaning, the units or the constraints.",
+		BadExample: `message Book {
+  // Title.
+  string title = 1;
+}`,
+		GoodExample: `message Book {
+  // The title as printed on the cover.
+  string title = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "min_words",
+				Description: fmt.Sprintf("The minimum number of words. The default is %d.", defaultCommentsMinWords),
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r CommentsMinWordsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := newCommentQualityVisitor(r.ID(), func(text string, _ string) []string {
+		if words := len(strings.Fields(text)); words < r.minWords {
+			return []string{fmt.Sprintf("should have at least %d words, but it has %d", r.minWords, words)}
+		}
+		return nil
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
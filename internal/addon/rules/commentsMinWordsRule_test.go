@@ -0,0 +1,149 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestCommentsMinWordsRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	tests := []struct {
+		name          string
+		inputMinWords int
+		inputProto    *parser.Proto
+		wantFailures  []report.Failure
+	}{
+		{
+			name: "no failures for proto with enough words or without comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book in the library.",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "title",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// The title",
+									},
+									{
+										Raw: "// of the book.",
+									},
+								},
+							},
+							&parser.Field{
+								FieldName: "isbn",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with too few words by default",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book.",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "title",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// protolint:disable:next MAX_LINE_LENGTH",
+									},
+									{
+										Raw: "// Title.",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						Comments: []*parser.Comment{
+							{
+								Raw: "/* A genre. */",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_MIN_WORDS", `Comment of the message "Book" should have at least 3 words, but it has 2`),
+				report.Failuref(pos, "COMMENTS_MIN_WORDS", `Comment of the field "title" should have at least 3 words, but it has 1`),
+				report.Failuref(pos, "COMMENTS_MIN_WORDS", `Comment of the enum "Genre" should have at least 3 words, but it has 2`),
+			},
+		},
+		{
+			name:          "failures for proto with too few words than the minimum",
+			inputMinWords: 5,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "LibraryService",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// The service of the library.",
+							},
+						},
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Gets a book.",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_MIN_WORDS", `Comment of the RPC "GetBook" should have at least 5 words, but it has 3`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewCommentsMinWordsRule(test.inputMinWords)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
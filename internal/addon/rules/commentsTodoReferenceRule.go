@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// defaultIssuePattern matches the issue references like #123 and PROJ-123.
+const defaultIssuePattern = `#\d+|\b[A-Z][A-Z0-9]+-\d+\b`
+
+// todoKeyword matches the keywords which leave the work for later.
+var todoKeyword = regexp.MustCompile(`\b(TODO|FIXME)\b`)
+
+// CommentsTodoReferenceRule verifies that the TODO and FIXME in the comments of the messages, fields, enums, services and RPCs have an issue reference.
+type CommentsTodoReferenceRule struct {
+	issuePattern string
+}
+
+// NewCommentsTodoReferenceRule creates a new CommentsTodoReferenceRule.
+// The default is used if issuePattern is empty.
+func NewCommentsTodoReferenceRule(
+	issuePattern string,
+) CommentsTodoReferenceRule {
+	if issuePattern == "" {
+		issuePattern = defaultIssuePattern
+	}
+	return CommentsTodoReferenceRule{
+		issuePattern: issuePattern,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r CommentsTodoReferenceRule) ID() string {
+	return "COMMENTS_TODO_REFERENCE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r CommentsTodoReferenceRule) Purpose() string {
+	return "Verifies that the TODO and FIXME in the comments have an issue reference."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r CommentsTodoReferenceRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r CommentsTodoReferenceRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A TODO without an issue is rarely done and ends up published in the API reference. The issue tracks the work and its owner.",
+		BadExample: `message Book {
+  // TODO: support the e-books.
+  string isbn = 1;
+}`,
+		GoodExample: `message Book {
+  // TODO(#123): support the e-books.
+  string isbn = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "issue_pattern",
+				Description: fmt.Sprintf("The regular expression of the issue references which must be on the same line as the keyword. The default is %q.", defaultIssuePattern),
+				Kind:        rule.OptionKindRegexp,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r CommentsTodoReferenceRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	issue, err := regexp.Compile(r.issuePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	v := newCommentQualityVisitor(r.ID(), func(text string, _ string) []string {
+		var problems []string
+		for _, line := range strings.Split(text, "\n") {
+			keyword := todoKeyword.FindString(line)
+			if keyword != "" && !issue.MatchString(line) {
+				problems = append(problems, fmt.Sprintf("should have an issue reference for the %s in %q", keyword, line))
+			}
+		}
+		return problems
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
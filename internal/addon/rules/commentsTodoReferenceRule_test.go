@@ -0,0 +1,144 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestCommentsTodoReferenceRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	tests := []struct {
+		name              string
+		inputIssuePattern string
+		inputProto        *parser.Proto
+		wantFailures      []report.Failure
+		wantExistErr      bool
+	}{
+		{
+			name: "no failures for proto with the TODOs with the issue references by default",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book.",
+							},
+							{
+								Raw: "// TODO(#123): support the e-books.",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "isbn",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// FIXME LIB-42 validate the checksum.",
+									},
+									{
+										Raw: "// Todos are fine.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the TODOs without the issue references by default",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// A book.",
+							},
+							{
+								Raw: "// TODO: support the e-books.",
+							},
+							{
+								Raw: "// FIXME: validate the ISBN.",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_TODO_REFERENCE", `Comment of the message "Book" should have an issue reference for the TODO in "TODO: support the e-books."`),
+				report.Failuref(pos, "COMMENTS_TODO_REFERENCE", `Comment of the message "Book" should have an issue reference for the FIXME in "FIXME: validate the ISBN."`),
+			},
+		},
+		{
+			name:              "failures for proto with the TODOs without the configured issue references",
+			inputIssuePattern: `LIB-\d+`,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "LibraryService",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// TODO(LIB-1): paginate.",
+							},
+						},
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// TODO(#1): cache.",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "COMMENTS_TODO_REFERENCE", `Comment of the RPC "GetBook" should have an issue reference for the TODO in "TODO(#1): cache."`),
+			},
+		},
+		{
+			name:              "an error for the invalid issue pattern",
+			inputIssuePattern: `LIB-(`,
+			inputProto:        &parser.Proto{},
+			wantExistErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewCommentsTodoReferenceRule(test.inputIssuePattern)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// The words which mark a deleted field in a comment.
+var defaultDeletedFieldKeywords = []string{
+	"deprecated",
+	"deleted",
+	"removed",
+	"obsolete",
+	"unused",
+}
+
+// deletedFieldDeclarationRegexp matches a field left in a comment like "string name = 3" or "field 3".
+var deletedFieldDeclarationRegexp = regexp.MustCompile(`(?i)(\b[A-Za-z_][\w.]*\s+[A-Za-z_]\w*\s*=\s*\d+|\b(field|tag|number)\s*#?\s*\d+)`)
+
+// DeletedFieldsUseReservedRule verifies that the deleted fields are reserved instead of left in comments like "// deprecated".
+type DeletedFieldsUseReservedRule struct {
+	keywords []string
+}
+
+// NewDeletedFieldsUseReservedRule creates a new DeletedFieldsUseReservedRule.
+func NewDeletedFieldsUseReservedRule(
+	keywords []string,
+) DeletedFieldsUseReservedRule {
+	if len(keywords) == 0 {
+		keywords = defaultDeletedFieldKeywords
+	}
+	return DeletedFieldsUseReservedRule{
+		keywords: keywords,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r DeletedFieldsUseReservedRule) ID() string {
+	return "DELETED_FIELDS_USE_RESERVED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r DeletedFieldsUseReservedRule) Purpose() string {
+	return `Verifies that the deleted fields are reserved instead of left in comments like "// deprecated".`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r DeletedFieldsUseReservedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r DeletedFieldsUseReservedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment doesn't stop anyone from reusing the number of a deleted field. reserved does, and protoc enforces it. " +
+			"The rule reports the comments with a keyword which also mention a field declaration or a field number. " +
+			"The comments of the fields, the enum values, the messages and the enums are not checked, since they describe the live elements.",
+		BadExample: `message Book {
+  string title = 1;
+  // deprecated: string isbn = 2;
+}`,
+		GoodExample: `message Book {
+  reserved 2;
+  reserved "isbn";
+  string title = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#reserved",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "keywords",
+				Description: "The words which mark a deleted field. The default is deprecated, deleted, removed, obsolete and unused.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r DeletedFieldsUseReservedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &deletedFieldsUseReservedVisitor{
+		BaseAddVisitor:   visitor.NewBaseAddVisitor(r.ID()),
+		keywords:         r.keywords,
+		attachedComments: make(map[*parser.Comment]bool),
+	}
+	v.collectAttachedComments(proto.ProtoBody)
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type deletedFieldsUseReservedVisitor struct {
+	*visitor.BaseAddVisitor
+	keywords []string
+	// attachedComments are the comments of the declarations, which describe the live elements like
+	// "// Deprecated: use field number 3 instead." of a deprecated field.
+	attachedComments map[*parser.Comment]bool
+}
+
+func (v *deletedFieldsUseReservedVisitor) attach(comments []*parser.Comment, inlineComments ...*parser.Comment) {
+	for _, comment := range append(comments, inlineComments...) {
+		if comment != nil {
+			v.attachedComments[comment] = true
+		}
+	}
+}
+
+func (v *deletedFieldsUseReservedVisitor) collectAttachedComments(body []parser.Visitee) {
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Message:
+			v.attach(e.Comments, e.InlineComment, e.InlineCommentBehindLeftCurly)
+			v.collectAttachedComments(e.MessageBody)
+		case *parser.Field:
+			v.attach(e.Comments, e.InlineComment)
+		case *parser.MapField:
+			v.attach(e.Comments, e.InlineComment)
+		case *parser.Oneof:
+			v.attach(e.Comments, e.InlineComment, e.InlineCommentBehindLeftCurly)
+			for _, field := range e.OneofFields {
+				v.attach(field.Comments, field.InlineComment)
+			}
+		case *parser.Enum:
+			v.attach(e.Comments, e.InlineComment, e.InlineCommentBehindLeftCurly)
+			v.collectAttachedComments(e.EnumBody)
+		case *parser.EnumField:
+			v.attach(e.Comments, e.InlineComment)
+		}
+	}
+}
+
+// VisitComment checks the comment which isn't attached to a declaration.
+func (v *deletedFieldsUseReservedVisitor) VisitComment(comment *parser.Comment) {
+	if v.attachedComments[comment] {
+		return
+	}
+	for _, line := range comment.Lines() {
+		line = strings.TrimSpace(line)
+		if v.hasKeyword(line) && deletedFieldDeclarationRegexp.MatchString(line) {
+			v.AddFailuref(comment.Meta.Pos, "Comment %q looks like a deleted field. Use reserved instead", line)
+			return
+		}
+	}
+}
+
+func (v *deletedFieldsUseReservedVisitor) hasKeyword(line string) bool {
+	for _, word := range strings.FieldsFunc(strings.ToLower(line), isNotWordRune) {
+		for _, keyword := range v.keywords {
+			if word == strings.ToLower(keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isNotWordRune(r rune) bool {
+	return !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+}
@@ -0,0 +1,142 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestDeletedFieldsUseReservedRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name          string
+		inputProto    *parser.Proto
+		inputKeywords []string
+		wantFailures  []report.Failure
+	}{
+		{
+			name: "no failures for the comments without the deleted fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "title",
+								FieldNumber: "1",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Deprecated: use name instead.",
+									},
+								},
+							},
+							&parser.Field{
+								FieldName:   "isbn",
+								FieldNumber: "2",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// isbn = 2 is the number.",
+									},
+								},
+							},
+							&parser.Field{
+								FieldName:   "old",
+								FieldNumber: "1",
+								FieldOptions: []*parser.FieldOption{
+									{
+										OptionName: "deprecated",
+										Constant:   "true",
+									},
+								},
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Deprecated: use field number 3 instead.",
+									},
+								},
+								InlineComment: &parser.Comment{
+									Raw: "// Deprecated: string old = 1 is removed in v2.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for the comments with the deleted fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "title",
+								FieldNumber: "1",
+							},
+							&parser.Comment{
+								Raw:  "// deprecated: string isbn = 2;",
+								Meta: meta.Meta{Pos: pos},
+							},
+							&parser.Comment{
+								Raw:  "/* Field 3 was removed. */",
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "DELETED_FIELDS_USE_RESERVED", `Comment "deprecated: string isbn = 2;" looks like a deleted field. Use reserved instead`),
+				report.Failuref(pos, "DELETED_FIELDS_USE_RESERVED", `Comment "Field 3 was removed." looks like a deleted field. Use reserved instead`),
+			},
+		},
+		{
+			name: "failures for the comments with the specified keywords",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Comment{
+								Raw:  "// Field 3 was removed.",
+								Meta: meta.Meta{Pos: pos},
+							},
+							&parser.Comment{
+								Raw:  "// RETIRED: int32 pages = 4;",
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			inputKeywords: []string{"retired"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "DELETED_FIELDS_USE_RESERVED", `Comment "RETIRED: int32 pages = 4;" looks like a deleted field. Use reserved instead`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewDeletedFieldsUseReservedRule(test.inputKeywords)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
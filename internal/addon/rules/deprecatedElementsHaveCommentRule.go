@@ -0,0 +1,164 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// DeprecatedElementsHaveCommentRule verifies that the deprecated fields, messages, enums and RPCs have a comment.
+// The comment is expected to explain the replacement, and optionally to have the removal date.
+type DeprecatedElementsHaveCommentRule struct {
+	removalDatePattern string
+}
+
+// NewDeprecatedElementsHaveCommentRule creates a new DeprecatedElementsHaveCommentRule.
+// The removal date is not verified if removalDatePattern is empty.
+func NewDeprecatedElementsHaveCommentRule(
+	removalDatePattern string,
+) DeprecatedElementsHaveCommentRule {
+	return DeprecatedElementsHaveCommentRule{
+		removalDatePattern: removalDatePattern,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r DeprecatedElementsHaveCommentRule) ID() string {
+	return "DEPRECATED_ELEMENTS_HAVE_COMMENT"
+}
+
+// Purpose returns the purpose of this rule.
+func (r DeprecatedElementsHaveCommentRule) Purpose() string {
+	return "Verifies that the deprecated fields, messages, enums and RPCs have a comment explaining the replacement."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r DeprecatedElementsHaveCommentRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r DeprecatedElementsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The generated code only warns that a deprecated element shouldn't be used. The clients need to know what to use instead and by when to migrate.",
+		BadExample: `message Book {
+  string isbn = 1 [deprecated = true];
+}`,
+		GoodExample: `message Book {
+  // Use isbn13 instead. Removal: 2025-06-30.
+  string isbn = 1 [deprecated = true];
+  string isbn13 = 2;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "removal_date_pattern",
+				Description: `The regular expression which the comment must match to have the removal date, e.g. "Removal: \d{4}-\d{2}-\d{2}". The default is empty, which doesn't verify the removal date.`,
+				Kind:        rule.OptionKindRegexp,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r DeprecatedElementsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	var removalDate *regexp.Regexp
+	if r.removalDatePattern != "" {
+		var err error
+		removalDate, err = regexp.Compile(r.removalDatePattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	v := &deprecatedElementsHaveCommentVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		removalDate:    removalDate,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type deprecatedElementsHaveCommentVisitor struct {
+	*visitor.BaseAddVisitor
+	removalDate *regexp.Regexp
+}
+
+// VisitMessage checks the message.
+func (v *deprecatedElementsHaveCommentVisitor) VisitMessage(message *parser.Message) bool {
+	if hasDeprecatedOption(message.MessageBody) {
+		v.check("message", message.MessageName, message.Comments, message.InlineComment, message.Meta.Pos)
+	}
+	return true
+}
+
+// VisitEnum checks the enum.
+func (v *deprecatedElementsHaveCommentVisitor) VisitEnum(enum *parser.Enum) bool {
+	if hasDeprecatedOption(enum.EnumBody) {
+		v.check("enum", enum.EnumName, enum.Comments, enum.InlineComment, enum.Meta.Pos)
+	}
+	return false
+}
+
+// VisitRPC checks the rpc.
+func (v *deprecatedElementsHaveCommentVisitor) VisitRPC(rpc *parser.RPC) bool {
+	if isDeprecatedRPC(rpc) {
+		v.check("RPC", rpc.RPCName, rpc.Comments, rpc.InlineComment, rpc.Meta.Pos)
+	}
+	return false
+}
+
+// VisitField checks the field.
+func (v *deprecatedElementsHaveCommentVisitor) VisitField(field *parser.Field) bool {
+	if isDeprecatedField(field.FieldOptions) {
+		v.check("field", field.FieldName, field.Comments, field.InlineComment, field.Meta.Pos)
+	}
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *deprecatedElementsHaveCommentVisitor) VisitMapField(field *parser.MapField) bool {
+	if isDeprecatedField(field.FieldOptions) {
+		v.check("field", field.MapName, field.Comments, field.InlineComment, field.Meta.Pos)
+	}
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *deprecatedElementsHaveCommentVisitor) VisitOneofField(field *parser.OneofField) bool {
+	if isDeprecatedField(field.FieldOptions) {
+		v.check("field", field.FieldName, field.Comments, field.InlineComment, field.Meta.Pos)
+	}
+	return false
+}
+
+func (v *deprecatedElementsHaveCommentVisitor) check(
+	kind string,
+	name string,
+	comments []*parser.Comment,
+	inlineComment *parser.Comment,
+	pos meta.Position,
+) {
+	if inlineComment != nil {
+		comments = append(append([]*parser.Comment{}, comments...), inlineComment)
+	}
+	if !hasComment(comments) {
+		v.AddFailuref(pos, "Deprecated %s %q should have a comment explaining the replacement", kind, name)
+		return
+	}
+	if v.removalDate == nil {
+		return
+	}
+
+	var lines []string
+	for _, comment := range comments {
+		lines = append(lines, comment.Lines()...)
+	}
+	if !v.removalDate.MatchString(strings.Join(lines, "\n")) {
+		v.AddFailuref(pos, "Comment of the deprecated %s %q should have the removal date matching `%s`", kind, name, v.removalDate.String())
+	}
+}
@@ -0,0 +1,219 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestDeprecatedElementsHaveCommentRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	deprecatedOption := &parser.Option{
+		OptionName: "deprecated",
+		Constant:   "true",
+	}
+	deprecatedFieldOptions := []*parser.FieldOption{
+		{
+			OptionName: "deprecated",
+			Constant:   "true",
+		},
+	}
+	tests := []struct {
+		name                    string
+		inputRemovalDatePattern string
+		inputProto              *parser.Proto
+		wantFailures            []report.Failure
+		wantExistErr            bool
+	}{
+		{
+			name: "no failures for proto without deprecated elements",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "isbn",
+								FieldOptions: []*parser.FieldOption{
+									{
+										OptionName: "deprecated",
+										Constant:   "false",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with deprecated elements with comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Author",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+						},
+						Comments: []*parser.Comment{
+							{
+								Raw: "// Use Person instead.",
+							},
+						},
+					},
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:     "isbn",
+								FieldOptions:  deprecatedFieldOptions,
+								InlineComment: &parser.Comment{Raw: "// Use isbn13 instead."},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with deprecated elements without comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+							&parser.Field{
+								FieldName:    "isbn",
+								FieldOptions: deprecatedFieldOptions,
+								Meta:         meta.Meta{Pos: pos},
+							},
+							&parser.MapField{
+								MapName:      "labels",
+								FieldOptions: deprecatedFieldOptions,
+								Meta:         meta.Meta{Pos: pos},
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:    "url",
+										FieldOptions: deprecatedFieldOptions,
+										Meta:         meta.Meta{Pos: pos},
+									},
+								},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						EnumBody: []parser.Visitee{
+							deprecatedOption,
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								Options: []*parser.Option{
+									deprecatedOption,
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated message "Book" should have a comment explaining the replacement`),
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated field "isbn" should have a comment explaining the replacement`),
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated field "labels" should have a comment explaining the replacement`),
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated field "url" should have a comment explaining the replacement`),
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated enum "Genre" should have a comment explaining the replacement`),
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", `Deprecated RPC "GetBook" should have a comment explaining the replacement`),
+			},
+		},
+		{
+			name:                    "failures for proto with deprecated elements without the removal dates",
+			inputRemovalDatePattern: `Removal: \d{4}-\d{2}-\d{2}`,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Author",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+						},
+						Comments: []*parser.Comment{
+							{
+								Raw: "// Use Person instead.\n// Removal: 2025-06-30.",
+							},
+						},
+					},
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:    "isbn",
+								FieldOptions: deprecatedFieldOptions,
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Use isbn13 instead.",
+									},
+								},
+								InlineComment: &parser.Comment{Raw: "// Removal: 2025-06-30."},
+							},
+							&parser.Field{
+								FieldName:    "title",
+								FieldOptions: deprecatedFieldOptions,
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Use name instead. Removal: soon.",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "DEPRECATED_ELEMENTS_HAVE_COMMENT", "Comment of the deprecated field \"title\" should have the removal date matching `Removal: \\d{4}-\\d{2}-\\d{2}`"),
+			},
+		},
+		{
+			name:                    "an error for the invalid removal date pattern",
+			inputRemovalDatePattern: `Removal: (`,
+			inputProto:              &parser.Proto{},
+			wantExistErr:            true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewDeprecatedElementsHaveCommentRule(test.inputRemovalDatePattern)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
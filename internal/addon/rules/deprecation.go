@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+)
+
+// deprecatedOptionName is the name of the option to mark the elements deprecated.
+const deprecatedOptionName = "deprecated"
+
+// hasDeprecatedOption decides whether or not the body of a message, an enum or a service has `option deprecated = true;`.
+func hasDeprecatedOption(body []parser.Visitee) bool {
+	for _, element := range body {
+		if option, ok := element.(*parser.Option); ok && option.OptionName == deprecatedOptionName && option.Constant == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// isDeprecatedRPC decides whether or not the rpc has `option deprecated = true;`.
+func isDeprecatedRPC(rpc *parser.RPC) bool {
+	for _, option := range rpc.Options {
+		if option.OptionName == deprecatedOptionName && option.Constant == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// isDeprecatedField decides whether or not the field options have `deprecated = true`.
+func isDeprecatedField(options []*parser.FieldOption) bool {
+	for _, option := range options {
+		if option.OptionName == deprecatedOptionName && option.Constant == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// typeDefinition is a message or an enum defined in the linted set.
+type typeDefinition struct {
+	kind       string
+	deprecated bool
+}
+
+// typeIndex looks up the messages and the enums by the fully-qualified names, e.g. acme.library.v1.Book.Genre.
+type typeIndex map[string]typeDefinition
+
+// newTypeIndex creates a typeIndex of the proto and the files of the linted set.
+// The types of the proto take precedence over those of the set with the same names.
+func newTypeIndex(proto *parser.Proto, protoSet *file.ParsedProtoSet) typeIndex {
+	index := make(typeIndex)
+	index.add(protoPackageName(proto), proto.ProtoBody)
+	for _, other := range protoSet.Protos() {
+		index.add(protoPackageName(other), other.ProtoBody)
+	}
+	return index
+}
+
+func (index typeIndex) add(scope string, body []parser.Visitee) {
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Message:
+			name := qualifiedName(scope, e.MessageName)
+			if _, ok := index[name]; !ok {
+				index[name] = typeDefinition{kind: "message", deprecated: hasDeprecatedOption(e.MessageBody)}
+			}
+			index.add(name, e.MessageBody)
+		case *parser.Enum:
+			name := qualifiedName(scope, e.EnumName)
+			if _, ok := index[name]; !ok {
+				index[name] = typeDefinition{kind: "enum", deprecated: hasDeprecatedOption(e.EnumBody)}
+			}
+		}
+	}
+}
+
+// resolve looks up the type referenced in the scope like the package or the enclosing message.
+// It returns false if the type is not in the index, e.g. it is a scalar type or in an imported file out of the linted set.
+func (index typeIndex) resolve(typeName string, scope string) (string, typeDefinition, bool) {
+	name := resolveTypeName(typeName, scope, func(name string) bool {
+		_, ok := index[name]
+		return ok
+	})
+	definition, ok := index[name]
+	return name, definition, ok
+}
@@ -0,0 +1,20 @@
+package rules
+
+import "strings"
+
+// featureOptionPrefix is the prefix of the options to set the features of the editions, e.g. features.field_presence.
+const featureOptionPrefix = "features."
+
+// isEdition decides whether or not the protobuf version is an edition like "2023".
+// The parser doesn't know the editions, so the edition of the edition statement is passed through as the version.
+func isEdition(version string) bool {
+	return version != "" && version != "proto2" && version != "proto3"
+}
+
+// featureName returns the name of the feature which the option sets, e.g. field_presence.
+func featureName(optionName string) (string, bool) {
+	if !strings.HasPrefix(optionName, featureOptionPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(optionName, featureOptionPrefix), true
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// EditionsFeaturesRule verifies that only the allowed features of the editions are set by the features.* options.
+// It checks the options of the file, the messages, the fields, the oneofs, the enums, the enum values, the services and the RPCs.
+type EditionsFeaturesRule struct {
+	allowedFeatures []string
+}
+
+// NewEditionsFeaturesRule creates a new EditionsFeaturesRule.
+// No features are allowed if allowedFeatures is empty.
+func NewEditionsFeaturesRule(
+	allowedFeatures []string,
+) EditionsFeaturesRule {
+	return EditionsFeaturesRule{
+		allowedFeatures: allowedFeatures,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r EditionsFeaturesRule) ID() string {
+	return "EDITIONS_FEATURES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EditionsFeaturesRule) Purpose() string {
+	return "Verifies that only the allowed features of the editions are set."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EditionsFeaturesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EditionsFeaturesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The features override the defaults of the edition for parts of the schema, so the same declaration behaves differently from file to file. Restricting them keeps the behaviors predictable.",
+		BadExample: `edition = "2023";
+
+message Book {
+  string title = 1 [features.field_presence = IMPLICIT];
+}`,
+		GoodExample: `edition = "2023";
+
+message Book {
+  string title = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "allowed_features",
+				Description: `The features allowed to be set, e.g. "field_presence". The default is none.`,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r EditionsFeaturesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &editionsFeaturesVisitor{
+		BaseAddVisitor:  visitor.NewBaseAddVisitor(r.ID()),
+		allowedFeatures: r.allowedFeatures,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type editionsFeaturesVisitor struct {
+	*visitor.BaseAddVisitor
+	allowedFeatures []string
+}
+
+func (v *editionsFeaturesVisitor) check(optionName string, pos meta.Position) {
+	feature, ok := featureName(optionName)
+	if ok && !stringsutil.ContainsStringInSlice(feature, v.allowedFeatures) {
+		v.AddFailuref(pos, "Feature %q should not be set", feature)
+	}
+}
+
+// VisitOption checks the option.
+func (v *editionsFeaturesVisitor) VisitOption(option *parser.Option) bool {
+	v.check(option.OptionName, option.Meta.Pos)
+	return false
+}
+
+// VisitRPC checks the options of the rpc.
+func (v *editionsFeaturesVisitor) VisitRPC(rpc *parser.RPC) bool {
+	for _, option := range rpc.Options {
+		v.check(option.OptionName, option.Meta.Pos)
+	}
+	return false
+}
+
+// VisitField checks the options of the field.
+func (v *editionsFeaturesVisitor) VisitField(field *parser.Field) bool {
+	for _, option := range field.FieldOptions {
+		v.check(option.OptionName, field.Meta.Pos)
+	}
+	return false
+}
+
+// VisitMapField checks the options of the map field.
+func (v *editionsFeaturesVisitor) VisitMapField(field *parser.MapField) bool {
+	for _, option := range field.FieldOptions {
+		v.check(option.OptionName, field.Meta.Pos)
+	}
+	return false
+}
+
+// VisitOneofField checks the options of the oneof field.
+func (v *editionsFeaturesVisitor) VisitOneofField(field *parser.OneofField) bool {
+	for _, option := range field.FieldOptions {
+		v.check(option.OptionName, field.Meta.Pos)
+	}
+	return false
+}
+
+// VisitEnumField checks the options of the enum field.
+func (v *editionsFeaturesVisitor) VisitEnumField(field *parser.EnumField) bool {
+	for _, option := range field.EnumValueOptions {
+		v.check(option.OptionName, field.Meta.Pos)
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEditionsFeaturesRule_Apply(t *testing.T) {
+	newPos := func(line int) meta.Position {
+		return meta.Position{
+			Filename: "example.proto",
+			Offset:   line * 10,
+			Line:     line,
+			Column:   1,
+		}
+	}
+	newOption := func(name string, line int) *parser.Option {
+		return &parser.Option{
+			OptionName: name,
+			Constant:   "VALUE",
+			Meta:       meta.Meta{Pos: newPos(line)},
+		}
+	}
+	newFieldOptions := func(name string) []*parser.FieldOption {
+		return []*parser.FieldOption{
+			{
+				OptionName: "deprecated",
+				Constant:   "true",
+			},
+			{
+				OptionName: name,
+				Constant:   "VALUE",
+			},
+		}
+	}
+
+	inputProto := &parser.Proto{
+		Syntax: &parser.Syntax{
+			ProtobufVersion: "2023",
+		},
+		ProtoBody: []parser.Visitee{
+			newOption("features.field_presence", 1),
+			newOption("go_package", 2),
+			&parser.Service{
+				ServiceBody: []parser.Visitee{
+					newOption("features.json_format", 3),
+					&parser.RPC{
+						Options: []*parser.Option{
+							newOption("features.enum_type", 4),
+						},
+					},
+				},
+			},
+			&parser.Message{
+				MessageBody: []parser.Visitee{
+					newOption("features.message_encoding", 5),
+					&parser.Field{
+						FieldOptions: newFieldOptions("features.field_presence"),
+						Meta:         meta.Meta{Pos: newPos(6)},
+					},
+					&parser.MapField{
+						FieldOptions: newFieldOptions("features.utf8_validation"),
+						Meta:         meta.Meta{Pos: newPos(7)},
+					},
+					&parser.Oneof{
+						Options: []*parser.Option{
+							newOption("features.field_presence", 8),
+						},
+						OneofFields: []*parser.OneofField{
+							{
+								FieldOptions: newFieldOptions("features.message_encoding"),
+								Meta:         meta.Meta{Pos: newPos(9)},
+							},
+						},
+					},
+				},
+			},
+			&parser.Enum{
+				EnumBody: []parser.Visitee{
+					newOption("features.enum_type", 10),
+					&parser.EnumField{
+						EnumValueOptions: []*parser.EnumValueOption{
+							{
+								OptionName: "features.enum_type",
+								Constant:   "CLOSED",
+							},
+						},
+						Meta: meta.Meta{Pos: newPos(11)},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		inputAllowedFeatures []string
+		inputProto           *parser.Proto
+		wantFailures         []report.Failure
+	}{
+		{
+			name: "no failures for proto without features",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "2023",
+				},
+				ProtoBody: []parser.Visitee{
+					newOption("go_package", 1),
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldOptions: newFieldOptions("json_name"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "failures for proto with features by default",
+			inputProto: inputProto,
+			wantFailures: []report.Failure{
+				report.Failuref(newPos(1), "EDITIONS_FEATURES", `Feature "field_presence" should not be set`),
+				report.Failuref(newPos(3), "EDITIONS_FEATURES", `Feature "json_format" should not be set`),
+				report.Failuref(newPos(4), "EDITIONS_FEATURES", `Feature "enum_type" should not be set`),
+				report.Failuref(newPos(5), "EDITIONS_FEATURES", `Feature "message_encoding" should not be set`),
+				report.Failuref(newPos(6), "EDITIONS_FEATURES", `Feature "field_presence" should not be set`),
+				report.Failuref(newPos(7), "EDITIONS_FEATURES", `Feature "utf8_validation" should not be set`),
+				report.Failuref(newPos(9), "EDITIONS_FEATURES", `Feature "message_encoding" should not be set`),
+				report.Failuref(newPos(8), "EDITIONS_FEATURES", `Feature "field_presence" should not be set`),
+				report.Failuref(newPos(10), "EDITIONS_FEATURES", `Feature "enum_type" should not be set`),
+				report.Failuref(newPos(11), "EDITIONS_FEATURES", `Feature "enum_type" should not be set`),
+			},
+		},
+		{
+			name:                 "failures for proto with features other than the allowed features",
+			inputAllowedFeatures: []string{"field_presence", "enum_type"},
+			inputProto:           inputProto,
+			wantFailures: []report.Failure{
+				report.Failuref(newPos(3), "EDITIONS_FEATURES", `Feature "json_format" should not be set`),
+				report.Failuref(newPos(5), "EDITIONS_FEATURES", `Feature "message_encoding" should not be set`),
+				report.Failuref(newPos(7), "EDITIONS_FEATURES", `Feature "utf8_validation" should not be set`),
+				report.Failuref(newPos(9), "EDITIONS_FEATURES", `Feature "message_encoding" should not be set`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEditionsFeaturesRule(test.inputAllowedFeatures)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
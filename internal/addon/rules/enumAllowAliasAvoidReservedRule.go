@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// EnumAllowAliasAvoidReservedRule verifies that the enums with allow_alias don't reuse the reserved numbers or names.
+type EnumAllowAliasAvoidReservedRule struct{}
+
+// NewEnumAllowAliasAvoidReservedRule creates a new EnumAllowAliasAvoidReservedRule.
+func NewEnumAllowAliasAvoidReservedRule() EnumAllowAliasAvoidReservedRule {
+	return EnumAllowAliasAvoidReservedRule{}
+}
+
+// ID returns the ID of this rule.
+func (r EnumAllowAliasAvoidReservedRule) ID() string {
+	return "ENUM_ALLOW_ALIAS_AVOID_RESERVED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EnumAllowAliasAvoidReservedRule) Purpose() string {
+	return "Verifies that the enums with allow_alias don't reuse the reserved numbers or names."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EnumAllowAliasAvoidReservedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EnumAllowAliasAvoidReservedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "allow_alias lets the values share a number, but not a reserved one. An alias of a deleted value silently brings it back for the old clients.",
+		BadExample: `enum Status {
+  option allow_alias = true;
+  reserved 2;
+  STATUS_UNSPECIFIED = 0;
+  STATUS_STARTED = 1;
+  STATUS_RUNNING = 1;
+  STATUS_DONE = 2;
+}`,
+		GoodExample: `enum Status {
+  option allow_alias = true;
+  reserved 2;
+  STATUS_UNSPECIFIED = 0;
+  STATUS_STARTED = 1;
+  STATUS_RUNNING = 1;
+  STATUS_DONE = 3;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#enum",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r EnumAllowAliasAvoidReservedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &enumAllowAliasAvoidReservedVisitor{
+		BaseAddVisitor:  visitor.NewBaseAddVisitor(r.ID()),
+		reservedNumbers: make(map[parser.Visitee]bool),
+		reservedNames:   make(map[parser.Visitee]bool),
+		enumNames:       make(map[parser.Visitee]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type enumAllowAliasAvoidReservedVisitor struct {
+	*visitor.BaseAddVisitor
+	reservedNumbers map[parser.Visitee]bool
+	reservedNames   map[parser.Visitee]bool
+	enumNames       map[parser.Visitee]string
+}
+
+// VisitEnum collects the enum values reusing the reserved numbers or names.
+func (v *enumAllowAliasAvoidReservedVisitor) VisitEnum(enum *parser.Enum) bool {
+	if !isAllowAliasEnum(enum) {
+		return false
+	}
+
+	reserved := newReservedSet(enum.EnumBody, maxEnumNumber)
+	for _, f := range numberedEnumFields(enum.EnumBody) {
+		v.enumNames[f.visitee] = enum.EnumName
+		if reserved.containsNumber(f.number) {
+			v.reservedNumbers[f.visitee] = true
+		}
+		if reserved.containsName(f.name) {
+			v.reservedNames[f.visitee] = true
+		}
+	}
+	return true
+}
+
+// VisitEnumField checks the enum value.
+func (v *enumAllowAliasAvoidReservedVisitor) VisitEnumField(field *parser.EnumField) bool {
+	if v.reservedNumbers[field] {
+		v.AddFailuref(
+			field.Meta.Pos,
+			"Enum value %q reuses the reserved number %s in the enum %q with allow_alias",
+			field.Ident,
+			field.Number,
+			v.enumNames[field],
+		)
+	}
+	if v.reservedNames[field] {
+		v.AddFailuref(
+			field.Meta.Pos,
+			"Enum value %q reuses the reserved name in the enum %q with allow_alias",
+			field.Ident,
+			v.enumNames[field],
+		)
+	}
+	return false
+}
+
+// isAllowAliasEnum decides whether or not the enum has option allow_alias = true.
+func isAllowAliasEnum(enum *parser.Enum) bool {
+	for _, element := range enum.EnumBody {
+		option, ok := element.(*parser.Option)
+		if ok && option.OptionName == "allow_alias" && option.Constant == "true" {
+			return true
+		}
+	}
+	return false
+}
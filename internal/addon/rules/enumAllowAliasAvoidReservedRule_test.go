@@ -0,0 +1,110 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEnumAllowAliasAvoidReservedRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for the enum without allow_alias",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "1"},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_DONE",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for the enum with allow_alias reusing the reserved values",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Status",
+						EnumBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "allow_alias",
+								Constant:   "true",
+							},
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2"},
+								},
+							},
+							&parser.Reserved{
+								FieldNames: []string{`"STATUS_FINISHED"`},
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_STARTED",
+								Number: "1",
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_RUNNING",
+								Number: "1",
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_DONE",
+								Number: "2",
+								Meta:   meta.Meta{Pos: pos},
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_FINISHED",
+								Number: "3",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ALLOW_ALIAS_AVOID_RESERVED", `Enum value "STATUS_DONE" reuses the reserved number 2 in the enum "Status" with allow_alias`),
+				report.Failuref(pos, "ENUM_ALLOW_ALIAS_AVOID_RESERVED", `Enum value "STATUS_FINISHED" reuses the reserved name in the enum "Status" with allow_alias`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumAllowAliasAvoidReservedRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/strs"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// EnumFieldNamesPrefixRule verifies that enum field names are prefixed with its ENUM_NAME_UPPER_SNAKE_CASE.
+// See https://developers.google.com/protocol-buffers/docs/style#enums.
+type EnumFieldNamesPrefixRule struct {
+	trimSuffixes []string
+	prefixes     map[string]string
+	fixMode      bool
+}
+
+// NewEnumFieldNamesPrefixRule creates a new EnumFieldNamesPrefixRule.
+func NewEnumFieldNamesPrefixRule(
+	trimSuffixes []string,
+	prefixes map[string]string,
+	fixMode bool,
+) EnumFieldNamesPrefixRule {
+	return EnumFieldNamesPrefixRule{
+		trimSuffixes: trimSuffixes,
+		prefixes:     prefixes,
+		fixMode:      fixMode,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r EnumFieldNamesPrefixRule) ID() string {
+	return "ENUM_FIELD_NAMES_PREFIX"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EnumFieldNamesPrefixRule) Purpose() string {
+	return "Verifies that enum field names are prefixed with its ENUM_NAME_UPPER_SNAKE_CASE."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EnumFieldNamesPrefixRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EnumFieldNamesPrefixRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Enum values share the scope of the enclosing package in C++ and other languages. Prefixing them with the enum name avoids collisions between enums. Run with -fix to add the prefix automatically. The fix renames the default values referencing the enum fields in the same file, but not the references in other files.",
+		BadExample: `enum Color {
+  UNSPECIFIED = 0;
+  RED = 1;
+}`,
+		GoodExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_RED = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#enums",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "trim_suffixes",
+				Description: "The suffixes to trim from the enum name before deriving the prefix, e.g. Type makes the prefix of ColorType COLOR_.",
+			},
+			{
+				Name:        "prefixes",
+				Description: "The prefixes to use instead of the derived ones, keyed by the enum name, e.g. BookFormat: FORMAT.",
+			},
+		},
+	}
+}
+
+// IsFixable decides whether or not this rule can fix the problems with the -fix flag.
+func (r EnumFieldNamesPrefixRule) IsFixable() bool {
+	return true
+}
+
+// Apply applies the rule to the proto.
+func (r EnumFieldNamesPrefixRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	var fileName string
+	if r.fixMode {
+		fileName = proto.Meta.Filename
+	}
+
+	v := &enumFieldNamesPrefixVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		trimSuffixes:   r.trimSuffixes,
+		prefixes:       r.prefixes,
+		fieldPrefixes:  make(map[*parser.EnumField]string),
+		fixMode:        r.fixMode,
+		protoFileName:  fileName,
+		types:          newTypeIndex(proto, nil),
+		enumNames:      make(map[*parser.Enum]string),
+		fieldEnums:     make(map[*parser.EnumField]string),
+		renames:        make(map[string]map[string]string),
+	}
+	if r.fixMode {
+		v.collectDefaults(protoPackageName(proto), proto.ProtoBody)
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type enumFieldRename struct {
+	line int
+	from string
+	to   string
+}
+
+// enumDefaultReference is a field whose default value can be the enum field, like `Color color = 1 [default = RED];` of proto2.
+type enumDefaultReference struct {
+	field *parser.Field
+	scope string
+	value string
+}
+
+type enumFieldNamesPrefixVisitor struct {
+	*visitor.BaseAddVisitor
+	trimSuffixes  []string
+	prefixes      map[string]string
+	fieldPrefixes map[*parser.EnumField]string
+
+	fixMode       bool
+	protoFileName string
+	types         typeIndex
+	// enumNames are the fully-qualified names of the enums, and fieldEnums are those of the enums of the enum fields.
+	enumNames  map[*parser.Enum]string
+	fieldEnums map[*parser.EnumField]string
+	defaults   []enumDefaultReference
+	// renames are the new names of the enum fields keyed by the fully-qualified enum names and the old names.
+	renames      map[string]map[string]string
+	fieldRenames []enumFieldRename
+}
+
+// collectDefaults records the fully-qualified names of the enums and the fields with the default values in the scope.
+func (v *enumFieldNamesPrefixVisitor) collectDefaults(scope string, body []parser.Visitee) {
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Message:
+			v.collectDefaults(qualifiedName(scope, e.MessageName), e.MessageBody)
+		case *parser.Enum:
+			v.enumNames[e] = qualifiedName(scope, e.EnumName)
+		case *parser.Field:
+			for _, option := range e.FieldOptions {
+				if option.OptionName == "default" {
+					v.defaults = append(v.defaults, enumDefaultReference{field: e, scope: scope, value: option.Constant})
+				}
+			}
+		}
+	}
+}
+
+// VisitEnum derives the prefix of the enum fields.
+func (v *enumFieldNamesPrefixVisitor) VisitEnum(enum *parser.Enum) bool {
+	prefix := v.prefix(enum.EnumName)
+	for _, body := range enum.EnumBody {
+		if field, ok := body.(*parser.EnumField); ok {
+			v.fieldPrefixes[field] = prefix
+			v.fieldEnums[field] = v.enumNames[enum]
+		}
+	}
+	return true
+}
+
+// VisitEnumField checks the enum field.
+func (v *enumFieldNamesPrefixVisitor) VisitEnumField(field *parser.EnumField) bool {
+	prefix, ok := v.fieldPrefixes[field]
+	if !ok || strings.HasPrefix(field.Ident, prefix) {
+		return false
+	}
+	v.AddFailuref(field.Meta.Pos, "EnumField name %q should have the prefix %q", field.Ident, prefix)
+
+	if v.fixMode {
+		rename := enumFieldRename{
+			line: field.Meta.Pos.Line - 1,
+			from: field.Ident,
+			to:   prefix + field.Ident,
+		}
+		v.fieldRenames = append(v.fieldRenames, rename)
+
+		enumName := v.fieldEnums[field]
+		if v.renames[enumName] == nil {
+			v.renames[enumName] = make(map[string]string)
+		}
+		v.renames[enumName][rename.from] = rename.to
+	}
+	return false
+}
+
+// Finally fixes the enum field names and the default values referencing them.
+// The names are looked up in the current lines, since the other rules like INDENT can have fixed them in advance.
+func (v *enumFieldNamesPrefixVisitor) Finally() error {
+	if !v.fixMode || len(v.fieldRenames) == 0 {
+		return nil
+	}
+
+	// Splitting by "\n" keeps the trailing "\r" of CRLF files in each line.
+	newline := "\n"
+	lines, err := osutil.ReadAllLines(v.protoFileName, newline)
+	if err != nil {
+		return err
+	}
+
+	for _, rename := range v.fieldRenames {
+		// The enum field is declared like `RED = 1;`.
+		declaration := regexp.MustCompile(`(^|[^\w.])(` + regexp.QuoteMeta(rename.from) + `)\s*=`)
+		if !replaceSubmatch(lines, rename.line, declaration, rename.to) {
+			return fmt.Errorf("failed to rename the enum field %q to %q, which is not found at the line %d", rename.from, rename.to, rename.line+1)
+		}
+	}
+
+	for _, reference := range v.defaults {
+		name, definition, ok := v.types.resolve(reference.field.Type, reference.scope)
+		if !ok || definition.kind != "enum" {
+			continue
+		}
+		to, ok := v.renames[name][reference.value]
+		if !ok {
+			continue
+		}
+		defaultValue := regexp.MustCompile(`(\bdefault\s*=\s*)(` + regexp.QuoteMeta(reference.value) + `)\b`)
+		if !replaceSubmatch(lines, reference.field.Meta.Pos.Line-1, defaultValue, to) {
+			return fmt.Errorf("failed to rename the default value %q of the field %q to %q", reference.value, reference.field.FieldName, to)
+		}
+	}
+	return osutil.WriteLinesToExistingFile(v.protoFileName, lines, newline)
+}
+
+// replaceSubmatch replaces the second submatch of the first match of the pattern with the new text.
+// It looks up the lines from the line to the end of the statement, and returns false if no lines match.
+func replaceSubmatch(lines []string, line int, pattern *regexp.Regexp, new string) bool {
+	for i := line; 0 <= i && i < len(lines); i++ {
+		if match := pattern.FindStringSubmatchIndex(lines[i]); match != nil {
+			lines[i] = lines[i][:match[4]] + new + lines[i][match[5]:]
+			return true
+		}
+		if strings.Contains(lines[i], ";") {
+			return false
+		}
+	}
+	return false
+}
+
+func (v *enumFieldNamesPrefixVisitor) prefix(enumName string) string {
+	return enumFieldPrefix(enumName, v.trimSuffixes, v.prefixes)
+}
+
+// enumFieldPrefix returns the prefix of the enum fields like COLOR_, following the trim_suffixes and prefixes options of ENUM_FIELD_NAMES_PREFIX.
+func enumFieldPrefix(
+	enumName string,
+	trimSuffixes []string,
+	prefixes map[string]string,
+) string {
+	if prefix, ok := prefixes[enumName]; ok {
+		return strings.TrimSuffix(prefix, "_") + "_"
+	}
+
+	name := enumName
+	for _, suffix := range trimSuffixes {
+		if trimmed := strings.TrimSuffix(name, suffix); trimmed != "" {
+			name = trimmed
+		}
+	}
+	return strs.ToUpperSnakeCase(name) + "_"
+}
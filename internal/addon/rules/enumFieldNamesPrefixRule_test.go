@@ -0,0 +1,332 @@
+package rules_test
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEnumFieldNamesPrefixRule_Apply(t *testing.T) {
+	tests := []struct {
+		name              string
+		inputProto        *parser.Proto
+		inputTrimSuffixes []string
+		inputPrefixes     map[string]string
+		wantFailures      []report.Failure
+	}{
+		{
+			name: "no failures for proto without enum fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with valid enum field names",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "BookStatus",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "BOOK_STATUS_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "BOOK_STATUS_AVAILABLE",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with invalid enum field names",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "BookStatus",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "UNSPECIFIED",
+								Number: "0",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "BOOK_AVAILABLE",
+								Number: "1",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   200,
+										Line:     10,
+										Column:   20,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_FIELD_NAMES_PREFIX",
+					`EnumField name "UNSPECIFIED" should have the prefix "BOOK_STATUS_"`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_FIELD_NAMES_PREFIX",
+					`EnumField name "BOOK_AVAILABLE" should have the prefix "BOOK_STATUS_"`,
+				),
+			},
+		},
+		{
+			name: "no failures for proto with the enum field names prefixed by the trimmed enum name",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "ColorType",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "Type",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "TYPE_UNSPECIFIED",
+								Number: "0",
+							},
+						},
+					},
+				},
+			},
+			inputTrimSuffixes: []string{"Type"},
+		},
+		{
+			name: "no failures for proto with the enum field names prefixed by the specified prefix",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "HTTPStatus",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "HTTP_STATUS_UNSPECIFIED",
+								Number: "0",
+							},
+						},
+					},
+				},
+			},
+			inputPrefixes: map[string]string{
+				"HTTPStatus": "HTTP_STATUS",
+			},
+		},
+		{
+			name: "a failure for proto with the enum name beginning with an acronym",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "HTTPStatus",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "HTTP_STATUS_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "HTTPSTATUS_OK",
+								Number: "1",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   200,
+										Line:     10,
+										Column:   20,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_FIELD_NAMES_PREFIX",
+					`EnumField name "HTTPSTATUS_OK" should have the prefix "HTTP_STATUS_"`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumFieldNamesPrefixRule(
+				test.inputTrimSuffixes,
+				test.inputPrefixes,
+				false,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func newTestEnumFieldNamesPrefixData(
+	fileName string,
+) (testData, error) {
+	return newTestData(setting_test.TestDataPath("rules", "enumFieldNamesPrefix", fileName))
+}
+
+func TestEnumFieldNamesPrefixRule_Apply_fix(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFilename string
+		// inputFixedFilename is the content of the input fixed by the other rules like INDENT before this rule.
+		inputFixedFilename string
+		wantFilename       string
+		wantExistErr       bool
+	}{
+		{
+			name:          "no fix for proto with prefixed enum field names",
+			inputFilename: "prefixed.proto",
+			wantFilename:  "prefixed.proto",
+		},
+		{
+			name:          "fix for proto with not prefixed enum field names",
+			inputFilename: "notPrefixed.proto",
+			wantFilename:  "prefixed.proto",
+		},
+		{
+			name:          "fix for proto with not prefixed enum field names and the default values referencing them",
+			inputFilename: "notPrefixedDefault.proto",
+			wantFilename:  "prefixedDefault.proto",
+		},
+		{
+			name:               "fix for proto with not prefixed enum field names reindented by the other fix",
+			inputFilename:      "notPrefixedMisindented.proto",
+			inputFixedFilename: "notPrefixed.proto",
+			wantFilename:       "prefixed.proto",
+		},
+		{
+			name:               "an error for proto with the enum field names not found",
+			inputFilename:      "notPrefixed.proto",
+			inputFixedFilename: "prefixed.proto",
+			wantFilename:       "prefixed.proto",
+			wantExistErr:       true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumFieldNamesPrefixRule(
+				[]string{"Type"},
+				nil,
+				true,
+			)
+
+			input, err := newTestEnumFieldNamesPrefixData(test.inputFilename)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			want, err := newTestEnumFieldNamesPrefixData(test.wantFilename)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			proto, err := file.NewProtoFile(input.filePath, input.filePath).Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+			defer func() {
+				if err := input.restore(); err != nil {
+					t.Errorf("got err %v", err)
+				}
+			}()
+
+			if test.inputFixedFilename != "" {
+				fixed, err := newTestEnumFieldNamesPrefixData(test.inputFixedFilename)
+				if err != nil {
+					t.Errorf("got err %v", err)
+					return
+				}
+				if err := ioutil.WriteFile(input.filePath, fixed.originData, 0); err != nil {
+					t.Errorf("got err %v", err)
+					return
+				}
+			}
+
+			_, err = rule.Apply(proto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			got, err := input.data()
+			if !reflect.DeepEqual(got, want.originData) {
+				t.Errorf(
+					"got %s(%v), but want %s(%v)",
+					string(got), got,
+					string(want.originData), want.originData,
+				)
+			}
+		})
+	}
+}
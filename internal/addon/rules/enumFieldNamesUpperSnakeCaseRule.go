@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -32,6 +33,20 @@ func (r EnumFieldNamesUpperSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r EnumFieldNamesUpperSnakeCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The style guide uses CAPITALS_WITH_UNDERSCORES for enum value names, so that they read as constants in every generated language.",
+		BadExample: `enum Color {
+  colorUnspecified = 0;
+}`,
+		GoodExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#enums",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesUpperSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumFieldNamesUpperSnakeCaseVisitor{
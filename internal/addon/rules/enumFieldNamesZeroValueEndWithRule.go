@@ -6,6 +6,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -46,6 +47,27 @@ func (r EnumFieldNamesZeroValueEndWithRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r EnumFieldNamesZeroValueEndWithRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The zero value is the default of an unset field in proto3. Naming it with a suffix like UNSPECIFIED makes it clear that the value carries no meaning.",
+		BadExample: `enum Color {
+  COLOR_RED = 0;
+}`,
+		GoodExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_RED = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#enums",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "suffix",
+				Description: `The suffix of the zero value. The default is "UNSPECIFIED".`,
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesZeroValueEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumFieldNamesZeroValueEndWithVisitor{
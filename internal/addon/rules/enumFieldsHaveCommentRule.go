@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -38,6 +39,26 @@ func (r EnumFieldsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r EnumFieldsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment on each enum value documents its meaning for the readers of the generated code.",
+		BadExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+}`,
+		GoodExample: `enum Color {
+  // COLOR_UNSPECIFIED means the color is unknown.
+  COLOR_UNSPECIFIED = 0;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the value. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumFieldsHaveCommentVisitor{
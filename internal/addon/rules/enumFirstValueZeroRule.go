@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// EnumFirstValueZeroRule verifies that the first enum value is zero for proto3 and editions.
+// See https://developers.google.com/protocol-buffers/docs/proto3#enum.
+type EnumFirstValueZeroRule struct{}
+
+// NewEnumFirstValueZeroRule creates a new EnumFirstValueZeroRule.
+func NewEnumFirstValueZeroRule() EnumFirstValueZeroRule {
+	return EnumFirstValueZeroRule{}
+}
+
+// ID returns the ID of this rule.
+func (r EnumFirstValueZeroRule) ID() string {
+	return "ENUM_FIRST_VALUE_ZERO"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EnumFirstValueZeroRule) Purpose() string {
+	return "Verifies that the first enum value is zero for proto3 and editions."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EnumFirstValueZeroRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EnumFirstValueZeroRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "In proto3 and editions, the first enum value is the default of an unset field and must be zero. protoc rejects the file otherwise.",
+		BadExample: `enum Color {
+  COLOR_RED = 1;
+  COLOR_UNSPECIFIED = 0;
+}`,
+		GoodExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_RED = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#enum",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r EnumFirstValueZeroRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &enumFirstValueZeroVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		enumNames:      make(map[*parser.EnumField]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type enumFirstValueZeroVisitor struct {
+	*visitor.BaseAddVisitor
+	// syntax is the syntax requiring the first zero value like proto3 or edition 2023, or empty for proto2.
+	syntax    string
+	enumNames map[*parser.EnumField]string
+}
+
+// VisitSyntax checks the syntax.
+func (v *enumFirstValueZeroVisitor) VisitSyntax(s *parser.Syntax) bool {
+	v.syntax = zeroValueRequiredSyntax(s.ProtobufVersion)
+	return false
+}
+
+// VisitEnum collects the first enum value which is not zero.
+func (v *enumFirstValueZeroVisitor) VisitEnum(enum *parser.Enum) bool {
+	if v.syntax == "" {
+		return false
+	}
+
+	fields := numberedEnumFields(enum.EnumBody)
+	if 0 < len(fields) && fields[0].number != 0 {
+		v.enumNames[fields[0].visitee.(*parser.EnumField)] = enum.EnumName
+	}
+	return true
+}
+
+// VisitEnumField checks the enum value.
+func (v *enumFirstValueZeroVisitor) VisitEnumField(field *parser.EnumField) bool {
+	if enumName, ok := v.enumNames[field]; ok {
+		v.AddFailuref(
+			field.Meta.Pos,
+			"The first value %q of the enum %q must be 0 for %s, but it is %s",
+			field.Ident,
+			enumName,
+			v.syntax,
+			field.Number,
+		)
+	}
+	return false
+}
+
+// zeroValueRequiredSyntax returns the syntax like proto3 or edition 2023 if the enums of the version require the zero value,
+// or empty for proto2.
+func zeroValueRequiredSyntax(version string) string {
+	switch {
+	case version == "proto3":
+		return version
+	case isEdition(version):
+		return "edition " + version
+	}
+	return ""
+}
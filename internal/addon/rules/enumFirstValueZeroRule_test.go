@@ -0,0 +1,140 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEnumFirstValueZeroRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto3 with the first zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto2 with the first non-zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto2",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto3 with the first non-zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "allow_alias",
+								Constant:   "true",
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+								Meta:   meta.Meta{Pos: pos},
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_FIRST_VALUE_ZERO", `The first value "COLOR_RED" of the enum "Color" must be 0 for proto3, but it is 1`),
+			},
+		},
+		{
+			name: "failures for editions with the first non-zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "2023",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_FIRST_VALUE_ZERO", `The first value "COLOR_RED" of the enum "Color" must be 0 for edition 2023, but it is 1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumFirstValueZeroRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -32,6 +33,20 @@ func (r EnumNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r EnumNamesUpperCamelCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The style guide uses CamelCase with an initial capital for enum type names.",
+		BadExample: `enum color_type {
+  COLOR_TYPE_UNSPECIFIED = 0;
+}`,
+		GoodExample: `enum ColorType {
+  COLOR_TYPE_UNSPECIFIED = 0;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#enums",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumNamesUpperCamelCaseVisitor{
@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// Default values are the names that give the default of an unset field a meaning.
+var defaultSemanticZeroValueNames = []string{
+	"OK",
+	"SUCCESS",
+	"NONE",
+	"NULL",
+	"EMPTY",
+	"DEFAULT",
+	"TRUE",
+	"FALSE",
+}
+
+// EnumZeroValueNotSemanticRule verifies that the zero value enum is not used semantically (e.g. "OK", "NONE").
+// See https://developers.google.com/protocol-buffers/docs/style#enums.
+type EnumZeroValueNotSemanticRule struct {
+	semanticNames []string
+	excludes      []string
+	trimSuffixes  []string
+	prefixes      map[string]string
+}
+
+// NewEnumZeroValueNotSemanticRule creates a new EnumZeroValueNotSemanticRule.
+// trimSuffixes and prefixes are the options of ENUM_FIELD_NAMES_PREFIX to derive the enum prefix.
+func NewEnumZeroValueNotSemanticRule(
+	semanticNames []string,
+	excludes []string,
+	trimSuffixes []string,
+	prefixes map[string]string,
+) EnumZeroValueNotSemanticRule {
+	if len(semanticNames) == 0 {
+		semanticNames = defaultSemanticZeroValueNames
+	}
+	return EnumZeroValueNotSemanticRule{
+		semanticNames: semanticNames,
+		excludes:      excludes,
+		trimSuffixes:  trimSuffixes,
+		prefixes:      prefixes,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r EnumZeroValueNotSemanticRule) ID() string {
+	return "ENUM_ZERO_VALUE_NOT_SEMANTIC"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EnumZeroValueNotSemanticRule) Purpose() string {
+	return `Verifies that the zero value enum is not used semantically (e.g. "OK", "NONE").`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EnumZeroValueNotSemanticRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EnumZeroValueNotSemanticRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The zero value is also what a client sends when it forgets to set the field. If it means OK or NONE, a missing value is silently read as a real one.",
+		BadExample: `enum Status {
+  STATUS_OK = 0;
+  STATUS_ERROR = 1;
+}`,
+		GoodExample: `enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OK = 1;
+  STATUS_ERROR = 2;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#enums",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "semantic_names",
+				Description: "The names without the enum prefix to disallow instead of the built-in list. The prefix follows the trim_suffixes and prefixes options of ENUM_FIELD_NAMES_PREFIX.",
+			},
+			{
+				Name:        "excludes",
+				Description: "The zero value names to allow, e.g. STATUS_OK.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r EnumZeroValueNotSemanticRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &enumZeroValueNotSemanticVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		semanticNames:  r.semanticNames,
+		excludes:       r.excludes,
+		trimSuffixes:   r.trimSuffixes,
+		prefixes:       r.prefixes,
+		fieldPrefixes:  make(map[*parser.EnumField]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type enumZeroValueNotSemanticVisitor struct {
+	*visitor.BaseAddVisitor
+	semanticNames []string
+	excludes      []string
+	trimSuffixes  []string
+	prefixes      map[string]string
+	fieldPrefixes map[*parser.EnumField]string
+}
+
+// VisitEnum collects the zero values with the prefix of the enum.
+func (v *enumZeroValueNotSemanticVisitor) VisitEnum(enum *parser.Enum) bool {
+	prefix := enumFieldPrefix(enum.EnumName, v.trimSuffixes, v.prefixes)
+	for _, f := range numberedEnumFields(enum.EnumBody) {
+		if f.number == 0 {
+			v.fieldPrefixes[f.visitee.(*parser.EnumField)] = prefix
+		}
+	}
+	return true
+}
+
+// VisitEnumField checks the enum field.
+func (v *enumZeroValueNotSemanticVisitor) VisitEnumField(field *parser.EnumField) bool {
+	prefix, ok := v.fieldPrefixes[field]
+	if !ok || stringsutil.ContainsStringInSlice(field.Ident, v.excludes) {
+		return false
+	}
+
+	name := strings.TrimPrefix(field.Ident, prefix)
+	for _, s := range v.semanticNames {
+		if strings.EqualFold(name, s) {
+			v.AddFailuref(field.Meta.Pos, "EnumField name %q with zero value should not mean %q", field.Ident, s)
+			break
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEnumZeroValueNotSemanticRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name               string
+		inputProto         *parser.Proto
+		inputSemanticNames []string
+		inputExcludes      []string
+		inputTrimSuffixes  []string
+		inputPrefixes      map[string]string
+		wantFailures       []report.Failure
+	}{
+		{
+			name: "no failures for proto with the zero value without meaning",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Status",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "STATUS_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "STATUS_OK",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the zero value with meaning",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Status",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "STATUS_OK",
+								Number: "0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "Kind",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "NONE",
+								Number: "0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ZERO_VALUE_NOT_SEMANTIC", `EnumField name "STATUS_OK" with zero value should not mean "OK"`),
+				report.Failuref(pos, "ENUM_ZERO_VALUE_NOT_SEMANTIC", `EnumField name "NONE" with zero value should not mean "NONE"`),
+			},
+		},
+		{
+			name: "failures for proto with the specified semantic names and excludes",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Status",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "STATUS_OK",
+								Number: "0",
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "Result",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "RESULT_PASSED",
+								Number: "0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			inputSemanticNames: []string{"OK", "PASSED"},
+			inputExcludes:      []string{"STATUS_OK"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ZERO_VALUE_NOT_SEMANTIC", `EnumField name "RESULT_PASSED" with zero value should not mean "PASSED"`),
+			},
+		},
+		{
+			name: "failures for proto with the prefixes following the options of ENUM_FIELD_NAMES_PREFIX",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "StatusType",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "STATUS_OK",
+								Number: "0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "HTTPCode",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "HTTP_NONE",
+								Number: "0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			inputTrimSuffixes: []string{"Type"},
+			inputPrefixes:     map[string]string{"HTTPCode": "HTTP"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ZERO_VALUE_NOT_SEMANTIC", `EnumField name "STATUS_OK" with zero value should not mean "OK"`),
+				report.Failuref(pos, "ENUM_ZERO_VALUE_NOT_SEMANTIC", `EnumField name "HTTP_NONE" with zero value should not mean "NONE"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumZeroValueNotSemanticRule(
+				test.inputSemanticNames,
+				test.inputExcludes,
+				test.inputTrimSuffixes,
+				test.inputPrefixes,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// EnumZeroValueUniqueRule verifies that exactly one enum value maps to zero.
+// The enums of proto2, which don't require the zero value, are only verified not to have the aliases of it.
+type EnumZeroValueUniqueRule struct{}
+
+// NewEnumZeroValueUniqueRule creates a new EnumZeroValueUniqueRule.
+func NewEnumZeroValueUniqueRule() EnumZeroValueUniqueRule {
+	return EnumZeroValueUniqueRule{}
+}
+
+// ID returns the ID of this rule.
+func (r EnumZeroValueUniqueRule) ID() string {
+	return "ENUM_ZERO_VALUE_UNIQUE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r EnumZeroValueUniqueRule) Purpose() string {
+	return "Verifies that exactly one enum value maps to zero."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r EnumZeroValueUniqueRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r EnumZeroValueUniqueRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The zero value is the default of an unset field. An enum without it has no safe default, and an alias of it makes the default ambiguous.",
+		BadExample: `enum Color {
+  option allow_alias = true;
+  COLOR_UNSPECIFIED = 0;
+  COLOR_UNKNOWN = 0;
+}`,
+		GoodExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_RED = 1;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r EnumZeroValueUniqueRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &enumZeroValueUniqueVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		firstZeros:     make(map[*parser.EnumField]string),
+		enumNames:      make(map[*parser.EnumField]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type enumZeroValueUniqueVisitor struct {
+	*visitor.BaseAddVisitor
+	requiresZero bool
+	firstZeros   map[*parser.EnumField]string
+	enumNames    map[*parser.EnumField]string
+}
+
+// VisitSyntax checks the syntax.
+func (v *enumZeroValueUniqueVisitor) VisitSyntax(s *parser.Syntax) bool {
+	v.requiresZero = zeroValueRequiredSyntax(s.ProtobufVersion) != ""
+	return false
+}
+
+// VisitEnum checks the enum has a zero value and collects the other zero values.
+func (v *enumZeroValueUniqueVisitor) VisitEnum(enum *parser.Enum) bool {
+	var first string
+	for _, f := range numberedEnumFields(enum.EnumBody) {
+		if f.number != 0 {
+			continue
+		}
+		if first == "" {
+			first = f.name
+			continue
+		}
+		field := f.visitee.(*parser.EnumField)
+		v.firstZeros[field] = first
+		v.enumNames[field] = enum.EnumName
+	}
+
+	if first == "" && v.requiresZero {
+		v.AddFailuref(enum.Meta.Pos, "Enum %q should have a value which maps to 0", enum.EnumName)
+	}
+	return true
+}
+
+// VisitEnumField checks the enum value.
+func (v *enumZeroValueUniqueVisitor) VisitEnumField(field *parser.EnumField) bool {
+	if first, ok := v.firstZeros[field]; ok {
+		v.AddFailuref(
+			field.Meta.Pos,
+			"Enum value %q also maps to 0 in the enum %q. Only %q should map to 0",
+			field.Ident,
+			v.enumNames[field],
+			first,
+		)
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestEnumZeroValueUniqueRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the unique zero value",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto2 without the zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto2",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto3 without the zero value",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ZERO_VALUE_UNIQUE", `Enum "Color" should have a value which maps to 0`),
+			},
+		},
+		{
+			name: "failures for proto with the aliases of the zero value",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumName: "Color",
+						EnumBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "allow_alias",
+								Constant:   "true",
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_UNKNOWN",
+								Number: "0x0",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "ENUM_ZERO_VALUE_UNIQUE", `Enum value "COLOR_UNKNOWN" also maps to 0 in the enum "Color". Only "COLOR_UNSPECIFIED" should map to 0`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewEnumZeroValueUniqueRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
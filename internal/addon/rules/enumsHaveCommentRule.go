@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -38,6 +39,26 @@ func (r EnumsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r EnumsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment on each enum documents what the set of values represents.",
+		BadExample: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+}`,
+		GoodExample: `// Color is the color of a book cover.
+enum Color {
+  COLOR_UNSPECIFIED = 0;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the enum. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumsHaveCommentVisitor{
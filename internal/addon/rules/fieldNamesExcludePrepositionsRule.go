@@ -7,6 +7,7 @@ import (
 
 	"github.com/tyhal/protolint/internal/stringsutil"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -75,6 +76,29 @@ func (r FieldNamesExcludePrepositionsRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r FieldNamesExcludePrepositionsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Prepositions make field names long and often hide a missing message, for example reason_for_error is better expressed as error_reason.",
+		BadExample: `message Book {
+  string reason_for_error = 1;
+}`,
+		GoodExample: `message Book {
+  string error_reason = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "prepositions",
+				Description: "The prepositions to use instead of the built-in list.",
+			},
+			{
+				Name:        "excludes",
+				Description: "The words containing prepositions to allow, e.g. end_of_support.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldNamesExcludePrepositionsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldNamesExcludePrepositionsVisitor{
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -32,6 +33,20 @@ func (r FieldNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r FieldNamesLowerSnakeCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The style guide uses underscore_separated_names for field names. The code generators convert them to the naming convention of each language.",
+		BadExample: `message Book {
+  string songName = 1;
+}`,
+		GoodExample: `message Book {
+  string song_name = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#message-and-field-names",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldNamesLowerSnakeCaseVisitor{
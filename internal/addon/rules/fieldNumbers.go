@@ -0,0 +1,190 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+// See https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers
+const (
+	minFieldNumber = 1
+	maxFieldNumber = 536870911
+	// maxEnumNumber is the max of the enum values, which are the 32-bit integers.
+	maxEnumNumber = 2147483647
+
+	// The numbers reserved for the Protocol Buffers implementation.
+	implementationReservedBegin = 19000
+	implementationReservedEnd   = 19999
+)
+
+// numberedField represents a field declared with a field number.
+type numberedField struct {
+	visitee parser.Visitee
+	name    string
+	number  int
+	pos     meta.Position
+}
+
+// numberedFields returns the fields declared directly in the message body in the declaration order.
+// The oneof fields are included because they share the numbers with the message.
+// The fields with the unparsable numbers are skipped.
+func numberedFields(body []parser.Visitee) []numberedField {
+	var fields []numberedField
+	add := func(visitee parser.Visitee, name string, number string, pos meta.Position) {
+		n, ok := parseFieldNumber(number)
+		if !ok {
+			return
+		}
+		fields = append(fields, numberedField{
+			visitee: visitee,
+			name:    name,
+			number:  n,
+			pos:     pos,
+		})
+	}
+
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Field:
+			add(e, e.FieldName, e.FieldNumber, e.Meta.Pos)
+		case *parser.MapField:
+			add(e, e.MapName, e.FieldNumber, e.Meta.Pos)
+		case *parser.GroupField:
+			add(e, e.GroupName, e.FieldNumber, e.Meta.Pos)
+		case *parser.Oneof:
+			for _, f := range e.OneofFields {
+				add(f, f.FieldName, f.FieldNumber, f.Meta.Pos)
+			}
+		}
+	}
+	return fields
+}
+
+// numberRange represents an inclusive range of numbers.
+type numberRange struct {
+	begin int
+	end   int
+}
+
+func (r numberRange) String() string {
+	if r.begin == r.end {
+		return strconv.Itoa(r.begin)
+	}
+	return fmt.Sprintf("%d to %d", r.begin, r.end)
+}
+
+// reservedRanges returns the ranges declared by the reserved and extensions statements in the message body.
+func reservedRanges(body []parser.Visitee) []numberRange {
+	var ranges []numberRange
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Reserved:
+			ranges = append(ranges, parseRanges(e.Ranges, maxFieldNumber)...)
+		case *parser.Extensions:
+			ranges = append(ranges, parseRanges(e.Ranges, maxFieldNumber)...)
+		}
+	}
+	return ranges
+}
+
+// parseRanges parses the ranges, where max is the upper bound of "max".
+// It is maxFieldNumber for the messages and maxEnumNumber for the enums.
+func parseRanges(rs []*parser.Range, max int) []numberRange {
+	var ranges []numberRange
+	for _, r := range rs {
+		begin, ok := parseFieldNumber(r.Begin)
+		if !ok {
+			continue
+		}
+		end := begin
+		switch r.End {
+		case "":
+		case "max":
+			end = max
+		default:
+			end, ok = parseFieldNumber(r.End)
+			if !ok {
+				continue
+			}
+		}
+		ranges = append(ranges, numberRange{begin: begin, end: end})
+	}
+	return ranges
+}
+
+// parseFieldNumber parses the number in the decimal, octal or hexadecimal form.
+func parseFieldNumber(s string) (int, bool) {
+	n, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// numberedEnumFields returns the enum values declared in the enum body in the declaration order.
+func numberedEnumFields(body []parser.Visitee) []numberedField {
+	var fields []numberedField
+	for _, element := range body {
+		f, ok := element.(*parser.EnumField)
+		if !ok {
+			continue
+		}
+		n, ok := parseFieldNumber(f.Number)
+		if !ok {
+			continue
+		}
+		fields = append(fields, numberedField{
+			visitee: f,
+			name:    f.Ident,
+			number:  n,
+			pos:     f.Meta.Pos,
+		})
+	}
+	return fields
+}
+
+// reservedSet represents the numbers and the names declared by the reserved statements.
+type reservedSet struct {
+	ranges []numberRange
+	names  map[string]bool
+}
+
+// newReservedSet creates a reservedSet, where max is the upper bound of "max" like parseRanges.
+func newReservedSet(body []parser.Visitee, max int) reservedSet {
+	s := reservedSet{
+		names: make(map[string]bool),
+	}
+	for _, element := range body {
+		r, ok := element.(*parser.Reserved)
+		if !ok {
+			continue
+		}
+		s.ranges = append(s.ranges, parseRanges(r.Ranges, max)...)
+		for _, name := range r.FieldNames {
+			s.names[unquote(name)] = true
+		}
+	}
+	return s
+}
+
+func (s reservedSet) containsNumber(n int) bool {
+	for _, r := range s.ranges {
+		if r.begin <= n && n <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (s reservedSet) containsName(name string) bool {
+	return s.names[name]
+}
+
+// unquote removes the quotes around the reserved name.
+func unquote(s string) string {
+	return strings.Trim(s, `"'`)
+}
@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FieldNumbersAscendingRule verifies that all fields are declared in ascending order of their numbers.
+type FieldNumbersAscendingRule struct{}
+
+// NewFieldNumbersAscendingRule creates a new FieldNumbersAscendingRule.
+func NewFieldNumbersAscendingRule() FieldNumbersAscendingRule {
+	return FieldNumbersAscendingRule{}
+}
+
+// ID returns the ID of this rule.
+func (r FieldNumbersAscendingRule) ID() string {
+	return "FIELD_NUMBERS_ASCENDING"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldNumbersAscendingRule) Purpose() string {
+	return "Verifies that all fields are declared in ascending order of their numbers."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldNumbersAscendingRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldNumbersAscendingRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Declaring the fields in the order of their numbers makes the next available number obvious, and the newly added fields easy to find.",
+		BadExample: `message Book {
+  string author = 2;
+  string title = 1;
+}`,
+		GoodExample: `message Book {
+  string title = 1;
+  string author = 2;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldNumbersAscendingRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldNumbersAscendingVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		descending:     make(map[parser.Visitee]descendingField),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldNumbersAscendingVisitor struct {
+	*visitor.BaseAddVisitor
+	descending map[parser.Visitee]descendingField
+}
+
+// descendingField represents a field whose number is less than the previous field.
+type descendingField struct {
+	field    numberedField
+	previous numberedField
+}
+
+// VisitMessage collects the descending fields in the message.
+func (v *fieldNumbersAscendingVisitor) VisitMessage(message *parser.Message) bool {
+	v.collect(message.MessageBody)
+	return true
+}
+
+// VisitField checks the field.
+func (v *fieldNumbersAscendingVisitor) VisitField(field *parser.Field) bool {
+	v.check(field)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *fieldNumbersAscendingVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldNumbersAscendingVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field)
+	return false
+}
+
+// VisitGroupField checks the group field, and collects the descending fields in the group.
+func (v *fieldNumbersAscendingVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field)
+	v.collect(field.MessageBody)
+	return true
+}
+
+func (v *fieldNumbersAscendingVisitor) collect(body []parser.Visitee) {
+	fields := numberedFields(body)
+	for i := 1; i < len(fields); i++ {
+		if fields[i].number < fields[i-1].number {
+			v.descending[fields[i].visitee] = descendingField{
+				field:    fields[i],
+				previous: fields[i-1],
+			}
+		}
+	}
+}
+
+func (v *fieldNumbersAscendingVisitor) check(visitee parser.Visitee) {
+	d, ok := v.descending[visitee]
+	if !ok {
+		return
+	}
+	v.AddFailuref(
+		d.field.pos,
+		"Field %q number %d must be greater than the previous field %q number %d",
+		d.field.name,
+		d.field.number,
+		d.previous.name,
+		d.previous.number,
+	)
+}
@@ -0,0 +1,107 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldNumbersAscendingRule_Apply(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with ascending field numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "second",
+										FieldNumber: "2",
+									},
+								},
+							},
+							&parser.MapField{
+								MapName:     "third",
+								FieldNumber: "5",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with descending field numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "2",
+							},
+							&parser.Field{
+								FieldName:   "second",
+								FieldNumber: "1",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+							&parser.Field{
+								FieldName:   "third",
+								FieldNumber: "3",
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"FIELD_NUMBERS_ASCENDING",
+					`Field "second" number 1 must be greater than the previous field "first" number 2`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldNumbersAscendingRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
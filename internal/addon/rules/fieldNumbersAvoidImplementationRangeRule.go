@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FieldNumbersAvoidImplementationRangeRule verifies that no field number is in the range 19000 to 19999.
+// See https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers.
+type FieldNumbersAvoidImplementationRangeRule struct{}
+
+// NewFieldNumbersAvoidImplementationRangeRule creates a new FieldNumbersAvoidImplementationRangeRule.
+func NewFieldNumbersAvoidImplementationRangeRule() FieldNumbersAvoidImplementationRangeRule {
+	return FieldNumbersAvoidImplementationRangeRule{}
+}
+
+// ID returns the ID of this rule.
+func (r FieldNumbersAvoidImplementationRangeRule) ID() string {
+	return "FIELD_NUMBERS_AVOID_IMPLEMENTATION_RANGE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldNumbersAvoidImplementationRangeRule) Purpose() string {
+	return "Verifies that no field number is in the range 19000 to 19999 reserved for the implementation."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldNumbersAvoidImplementationRangeRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldNumbersAvoidImplementationRangeRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The numbers 19000 through 19999 are reserved for the Protocol Buffers implementation. protoc rejects them.",
+		BadExample:    "string title = 19000;",
+		GoodExample:   "string title = 1;",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldNumbersAvoidImplementationRangeRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldNumbersAvoidImplementationRangeVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldNumbersAvoidImplementationRangeVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitField checks the field.
+func (v *fieldNumbersAvoidImplementationRangeVisitor) VisitField(field *parser.Field) bool {
+	v.check(field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *fieldNumbersAvoidImplementationRangeVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field.MapName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldNumbersAvoidImplementationRangeVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitGroupField checks the group field.
+func (v *fieldNumbersAvoidImplementationRangeVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field.GroupName, field.FieldNumber, field.Meta.Pos)
+	return true
+}
+
+func (v *fieldNumbersAvoidImplementationRangeVisitor) check(
+	name string,
+	number string,
+	pos meta.Position,
+) {
+	n, ok := parseFieldNumber(number)
+	if !ok {
+		return
+	}
+	if implementationReservedBegin <= n && n <= implementationReservedEnd {
+		v.AddFailuref(
+			pos,
+			"Field %q number %d must not be in the range %d to %d reserved for the implementation",
+			name,
+			n,
+			implementationReservedBegin,
+			implementationReservedEnd,
+		)
+	}
+}
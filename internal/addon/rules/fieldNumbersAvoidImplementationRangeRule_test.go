@@ -0,0 +1,125 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldNumbersAvoidImplementationRangeRule_Apply(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto without fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with valid field numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "18999",
+							},
+							&parser.MapField{
+								MapName:     "second",
+								FieldNumber: "20000",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the field numbers in the range",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "19000",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "second",
+										FieldNumber: "0x4E1F",
+										Meta: meta.Meta{
+											Pos: meta.Position{
+												Filename: "example.proto",
+												Offset:   150,
+												Line:     7,
+												Column:   10,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"FIELD_NUMBERS_AVOID_IMPLEMENTATION_RANGE",
+					`Field "first" number 19000 must not be in the range 19000 to 19999 reserved for the implementation`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   150,
+						Line:     7,
+						Column:   10,
+					},
+					"FIELD_NUMBERS_AVOID_IMPLEMENTATION_RANGE",
+					`Field "second" number 19999 must not be in the range 19000 to 19999 reserved for the implementation`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldNumbersAvoidImplementationRangeRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
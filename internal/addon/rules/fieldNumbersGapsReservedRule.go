@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"sort"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FieldNumbersGapsReservedRule verifies that the unused field numbers below the largest one are reserved.
+type FieldNumbersGapsReservedRule struct{}
+
+// NewFieldNumbersGapsReservedRule creates a new FieldNumbersGapsReservedRule.
+func NewFieldNumbersGapsReservedRule() FieldNumbersGapsReservedRule {
+	return FieldNumbersGapsReservedRule{}
+}
+
+// ID returns the ID of this rule.
+func (r FieldNumbersGapsReservedRule) ID() string {
+	return "FIELD_NUMBERS_GAPS_RESERVED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldNumbersGapsReservedRule) Purpose() string {
+	return "Verifies that the unused field numbers below the largest one are reserved."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldNumbersGapsReservedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldNumbersGapsReservedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A gap in the field numbers usually means a field was removed. Reusing its number breaks the old clients, so it should be reserved. The gaps are clipped at the implementation-reserved numbers 19000 to 19999, which are never reported.",
+		BadExample: `message Book {
+  string title = 1;
+  string isbn = 3;
+}`,
+		GoodExample: `message Book {
+  reserved 2;
+  string title = 1;
+  string isbn = 3;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#reserved",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldNumbersGapsReservedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldNumbersGapsReservedVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldNumbersGapsReservedVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitMessage checks the message.
+func (v *fieldNumbersGapsReservedVisitor) VisitMessage(message *parser.Message) bool {
+	v.check(message.MessageName, message.MessageBody, message.Meta.Pos)
+	return true
+}
+
+// VisitGroupField checks the group field.
+func (v *fieldNumbersGapsReservedVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field.GroupName, field.MessageBody, field.Meta.Pos)
+	return true
+}
+
+func (v *fieldNumbersGapsReservedVisitor) check(
+	name string,
+	body []parser.Visitee,
+	pos meta.Position,
+) {
+	fields := numberedFields(body)
+	if len(fields) == 0 {
+		return
+	}
+
+	// The implementation-reserved numbers count as used, so that the gaps around them end at 18999 and begin at 20000.
+	used := []numberRange{
+		{begin: implementationReservedBegin, end: implementationReservedEnd},
+	}
+	largest := 0
+	for _, f := range fields {
+		used = append(used, numberRange{begin: f.number, end: f.number})
+		if largest < f.number {
+			largest = f.number
+		}
+	}
+	used = append(used, reservedRanges(body)...)
+
+	for _, gap := range gaps(used, minFieldNumber, largest) {
+		if gap.begin == gap.end {
+			v.AddFailuref(pos, "Field number %s is neither used nor reserved in %q", gap, name)
+			continue
+		}
+		v.AddFailuref(pos, "Field numbers %s are neither used nor reserved in %q", gap, name)
+	}
+}
+
+// gaps returns the ranges between from and to which none of the used ranges contains.
+func gaps(
+	used []numberRange,
+	from int,
+	to int,
+) []numberRange {
+	sorted := make([]numberRange, len(used))
+	copy(sorted, used)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].begin < sorted[j].begin
+	})
+
+	var gs []numberRange
+	n := from
+	for _, r := range sorted {
+		if to < n {
+			break
+		}
+		if r.end < n {
+			continue
+		}
+		if n < r.begin {
+			end := r.begin - 1
+			if to < end {
+				end = to
+			}
+			gs = append(gs, numberRange{begin: n, end: end})
+		}
+		n = r.end + 1
+	}
+	if n <= to {
+		gs = append(gs, numberRange{begin: n, end: to})
+	}
+	return gs
+}
@@ -0,0 +1,181 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldNumbersGapsReservedRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with reserved gaps",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2"},
+									{Begin: "4", End: "5"},
+								},
+							},
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.Field{
+								FieldName:   "third",
+								FieldNumber: "3",
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "sixth",
+										FieldNumber: "6",
+									},
+								},
+							},
+							&parser.Field{
+								FieldName:   "large",
+								FieldNumber: "20000",
+							},
+							&parser.Extensions{
+								Ranges: []*parser.Range{
+									{Begin: "7", End: "18999"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with unreserved gaps",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "3"},
+								},
+							},
+							&parser.Field{
+								FieldName:   "fourth",
+								FieldNumber: "4",
+							},
+							&parser.Field{
+								FieldName:   "eighth",
+								FieldNumber: "8",
+							},
+						},
+						Meta: meta.Meta{
+							Pos: pos,
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field numbers 1 to 2 are neither used nor reserved in "Book"`),
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field numbers 5 to 7 are neither used nor reserved in "Book"`),
+			},
+		},
+		{
+			name: "a failure for proto with an unreserved number",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.Field{
+								FieldName:   "third",
+								FieldNumber: "3",
+							},
+						},
+						Meta: meta.Meta{
+							Pos: pos,
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field number 2 is neither used nor reserved in "Book"`),
+			},
+		},
+		{
+			name: "failures for the gaps clipped at the implementation-reserved numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.Field{
+								FieldName:   "third",
+								FieldNumber: "3",
+							},
+							&parser.Field{
+								FieldName:   "extended",
+								FieldNumber: "20000",
+							},
+							&parser.Field{
+								FieldName:   "extended_more",
+								FieldNumber: "30000",
+							},
+						},
+						Meta: meta.Meta{
+							Pos: pos,
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field number 2 is neither used nor reserved in "Book"`),
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field numbers 4 to 18999 are neither used nor reserved in "Book"`),
+				report.Failuref(pos, "FIELD_NUMBERS_GAPS_RESERVED", `Field numbers 20001 to 29999 are neither used nor reserved in "Book"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldNumbersGapsReservedRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FieldNumbersUniqueRule verifies that no field number is used twice in a message, including its oneofs.
+type FieldNumbersUniqueRule struct{}
+
+// NewFieldNumbersUniqueRule creates a new FieldNumbersUniqueRule.
+func NewFieldNumbersUniqueRule() FieldNumbersUniqueRule {
+	return FieldNumbersUniqueRule{}
+}
+
+// ID returns the ID of this rule.
+func (r FieldNumbersUniqueRule) ID() string {
+	return "FIELD_NUMBERS_UNIQUE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldNumbersUniqueRule) Purpose() string {
+	return "Verifies that no field number is used twice in a message, including its oneofs."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldNumbersUniqueRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldNumbersUniqueRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The field number identifies the field on the wire. The oneof fields share the numbers with the other fields of the message.",
+		BadExample: `message Book {
+  string title = 1;
+  oneof id {
+    string isbn = 1;
+  }
+}`,
+		GoodExample: `message Book {
+  string title = 1;
+  oneof id {
+    string isbn = 2;
+  }
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldNumbersUniqueRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldNumbersUniqueVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		duplicates:     make(map[parser.Visitee]duplicatedField),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldNumbersUniqueVisitor struct {
+	*visitor.BaseAddVisitor
+	duplicates map[parser.Visitee]duplicatedField
+}
+
+// duplicatedField represents a field whose number is already used by the field declared first.
+type duplicatedField struct {
+	field numberedField
+	first numberedField
+}
+
+// VisitMessage collects the duplicated fields in the message.
+func (v *fieldNumbersUniqueVisitor) VisitMessage(message *parser.Message) bool {
+	v.collect(message.MessageBody)
+	return true
+}
+
+// VisitField checks the field.
+func (v *fieldNumbersUniqueVisitor) VisitField(field *parser.Field) bool {
+	v.check(field)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *fieldNumbersUniqueVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldNumbersUniqueVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field)
+	return false
+}
+
+// VisitGroupField checks the group field, and collects the duplicated fields in the group.
+func (v *fieldNumbersUniqueVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field)
+	v.collect(field.MessageBody)
+	return true
+}
+
+func (v *fieldNumbersUniqueVisitor) collect(body []parser.Visitee) {
+	first := make(map[int]numberedField)
+	for _, field := range numberedFields(body) {
+		if f, ok := first[field.number]; ok {
+			v.duplicates[field.visitee] = duplicatedField{
+				field: field,
+				first: f,
+			}
+			continue
+		}
+		first[field.number] = field
+	}
+}
+
+func (v *fieldNumbersUniqueVisitor) check(visitee parser.Visitee) {
+	d, ok := v.duplicates[visitee]
+	if !ok {
+		return
+	}
+	v.AddFailuref(d.field.pos, "Field %q number %d is already used by field %q", d.field.name, d.field.number, d.first.name)
+}
@@ -0,0 +1,133 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldNumbersUniqueRule_Apply(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with unique field numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.Message{
+								MessageBody: []parser.Visitee{
+									&parser.Field{
+										FieldName:   "nested",
+										FieldNumber: "1",
+									},
+								},
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "second",
+										FieldNumber: "2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with duplicated field numbers",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:   "first",
+								FieldNumber: "1",
+							},
+							&parser.MapField{
+								MapName:     "second",
+								FieldNumber: "1",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "third",
+										FieldNumber: "1",
+										Meta: meta.Meta{
+											Pos: meta.Position{
+												Filename: "example.proto",
+												Offset:   150,
+												Line:     7,
+												Column:   10,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"FIELD_NUMBERS_UNIQUE",
+					`Field "second" number 1 is already used by field "first"`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   150,
+						Line:     7,
+						Column:   10,
+					},
+					"FIELD_NUMBERS_UNIQUE",
+					`Field "third" number 1 is already used by field "first"`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldNumbersUniqueRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
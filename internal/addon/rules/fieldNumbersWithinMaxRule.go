@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FieldNumbersWithinMaxRule verifies that all field numbers are between 1 and 536,870,911.
+// See https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers.
+type FieldNumbersWithinMaxRule struct{}
+
+// NewFieldNumbersWithinMaxRule creates a new FieldNumbersWithinMaxRule.
+func NewFieldNumbersWithinMaxRule() FieldNumbersWithinMaxRule {
+	return FieldNumbersWithinMaxRule{}
+}
+
+// ID returns the ID of this rule.
+func (r FieldNumbersWithinMaxRule) ID() string {
+	return "FIELD_NUMBERS_WITHIN_MAX"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldNumbersWithinMaxRule) Purpose() string {
+	return "Verifies that all field numbers are between 1 and 536,870,911."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldNumbersWithinMaxRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldNumbersWithinMaxRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The field numbers are encoded in 29 bits. protoc rejects the numbers out of the range.",
+		BadExample:    "string title = 536870912;",
+		GoodExample:   "string title = 1;",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#assigning_field_numbers",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldNumbersWithinMaxRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldNumbersWithinMaxVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldNumbersWithinMaxVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitField checks the field.
+func (v *fieldNumbersWithinMaxVisitor) VisitField(field *parser.Field) bool {
+	v.check(field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *fieldNumbersWithinMaxVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field.MapName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldNumbersWithinMaxVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitGroupField checks the group field.
+func (v *fieldNumbersWithinMaxVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field.GroupName, field.FieldNumber, field.Meta.Pos)
+	return true
+}
+
+func (v *fieldNumbersWithinMaxVisitor) check(
+	name string,
+	number string,
+	pos meta.Position,
+) {
+	n, ok := parseFieldNumber(number)
+	if !ok {
+		return
+	}
+	if n < minFieldNumber || maxFieldNumber < n {
+		v.AddFailuref(pos, "Field %q number %d must be between %d and %d", name, n, minFieldNumber, maxFieldNumber)
+	}
+}
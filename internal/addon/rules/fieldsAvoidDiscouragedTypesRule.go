@@ -0,0 +1,164 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// Default values are the well-known types which lose the schema of the values.
+var defaultDiscouragedTypes = []string{
+	"google.protobuf.Any",
+	"google.protobuf.Struct",
+	"google.protobuf.Value",
+	"google.protobuf.ListValue",
+}
+
+// FieldsAvoidDiscouragedTypesRule verifies that the fields don't use the discouraged types (e.g. "google.protobuf.Any")
+// except the allowed fields.
+type FieldsAvoidDiscouragedTypesRule struct {
+	types         []string
+	allowedFields []string
+}
+
+// NewFieldsAvoidDiscouragedTypesRule creates a new FieldsAvoidDiscouragedTypesRule.
+func NewFieldsAvoidDiscouragedTypesRule(
+	types []string,
+	allowedFields []string,
+) FieldsAvoidDiscouragedTypesRule {
+	if len(types) == 0 {
+		types = defaultDiscouragedTypes
+	}
+	return FieldsAvoidDiscouragedTypesRule{
+		types:         types,
+		allowedFields: allowedFields,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r FieldsAvoidDiscouragedTypesRule) ID() string {
+	return "FIELDS_AVOID_DISCOURAGED_TYPES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldsAvoidDiscouragedTypesRule) Purpose() string {
+	return `Verifies that the fields don't use the discouraged types (e.g. "google.protobuf.Any") except the allowed fields.`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldsAvoidDiscouragedTypesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldsAvoidDiscouragedTypesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Any and Struct carry values without a schema, so neither the compiler nor the linter can check what goes through them. Keep them to the few fields designed to be open, like the error details.",
+		BadExample: `message Book {
+  google.protobuf.Any metadata = 1;
+}`,
+		GoodExample: `message Book {
+  BookMetadata metadata = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "types",
+				Description: "The discouraged types instead of the built-in list of Any, Struct, Value and ListValue.",
+			},
+			{
+				Name:        "allowed_fields",
+				Description: "The field patterns allowed to use the discouraged types, matched against the field name qualified by the messages, e.g. Status.details or *.metadata. The pattern syntax is that of path.Match.",
+				Kind:        rule.OptionKindGlob,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldsAvoidDiscouragedTypesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	for _, pattern := range r.allowedFields {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	v := &fieldsAvoidDiscouragedTypesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		types:          r.types,
+		allowedFields:  r.allowedFields,
+		qualifiedNames: qualifiedFieldNames(proto),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldsAvoidDiscouragedTypesVisitor struct {
+	*visitor.BaseAddVisitor
+	types          []string
+	allowedFields  []string
+	qualifiedNames map[parser.Visitee]string
+}
+
+// VisitField checks the field.
+func (v *fieldsAvoidDiscouragedTypesVisitor) VisitField(field *parser.Field) bool {
+	v.check(field, field.Type, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *fieldsAvoidDiscouragedTypesVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field, field.Type, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldsAvoidDiscouragedTypesVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field, field.Type, field.Meta.Pos)
+	return false
+}
+
+func (v *fieldsAvoidDiscouragedTypesVisitor) check(field parser.Visitee, typ string, pos meta.Position) {
+	typ = fullMessageType(typ)
+	if !stringsutil.ContainsStringInSlice(typ, v.types) {
+		return
+	}
+	name := v.qualifiedNames[field]
+	if matchesAny(name, v.allowedFields) {
+		return
+	}
+	v.AddFailuref(pos, "Field %q should avoid %s", name, typ)
+}
+
+// qualifiedFieldNames returns the field names qualified by the enclosing messages, e.g. Outer.Inner.field.
+func qualifiedFieldNames(proto *parser.Proto) map[parser.Visitee]string {
+	names := make(map[parser.Visitee]string)
+	var walk func(prefix string, body []parser.Visitee)
+	walk = func(prefix string, body []parser.Visitee) {
+		for _, element := range body {
+			switch e := element.(type) {
+			case *parser.Message:
+				walk(prefix+e.MessageName+".", e.MessageBody)
+			case *parser.Extend:
+				walk(prefix, e.ExtendBody)
+			case *parser.GroupField:
+				names[e] = prefix + e.GroupName
+				walk(prefix+e.GroupName+".", e.MessageBody)
+			case *parser.Field:
+				names[e] = prefix + e.FieldName
+			case *parser.MapField:
+				names[e] = prefix + e.MapName
+			case *parser.Oneof:
+				for _, f := range e.OneofFields {
+					names[f] = prefix + f.FieldName
+				}
+			}
+		}
+	}
+	walk("", proto.ProtoBody)
+	return names
+}
@@ -0,0 +1,152 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldsAvoidDiscouragedTypesRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newField := func(typ, name string) *parser.Field {
+		return &parser.Field{
+			Type:      typ,
+			FieldName: name,
+			Meta:      meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		inputProto         *parser.Proto
+		inputTypes         []string
+		inputAllowedFields []string
+		wantFailures       []report.Failure
+		wantExistErr       bool
+	}{
+		{
+			name: "no failures for proto without the discouraged types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("BookMetadata", "metadata"),
+							newField("google.protobuf.Timestamp", "create_time"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the discouraged types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("google.protobuf.Any", "metadata"),
+							&parser.MapField{
+								KeyType: "string",
+								Type:    ".google.protobuf.Value",
+								MapName: "labels",
+								Meta:    meta.Meta{Pos: pos},
+							},
+							&parser.Message{
+								MessageName: "Page",
+								MessageBody: []parser.Visitee{
+									&parser.Oneof{
+										OneofFields: []*parser.OneofField{
+											{
+												Type:      "google.protobuf.Struct",
+												FieldName: "content",
+												Meta:      meta.Meta{Pos: pos},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELDS_AVOID_DISCOURAGED_TYPES", `Field "Book.metadata" should avoid google.protobuf.Any`),
+				report.Failuref(pos, "FIELDS_AVOID_DISCOURAGED_TYPES", `Field "Book.labels" should avoid google.protobuf.Value`),
+				report.Failuref(pos, "FIELDS_AVOID_DISCOURAGED_TYPES", `Field "Book.Page.content" should avoid google.protobuf.Struct`),
+			},
+		},
+		{
+			name: "failures for proto with the custom types except the allowed fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Status",
+						MessageBody: []parser.Visitee{
+							newField("google.protobuf.Any", "details"),
+							newField("google.protobuf.Any", "cause"),
+							newField("google.protobuf.Struct", "context"),
+							newField("bytes", "payload"),
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("google.protobuf.Any", "metadata"),
+						},
+					},
+				},
+			},
+			inputTypes:         []string{"google.protobuf.Any", "bytes"},
+			inputAllowedFields: []string{"Status.details", "*.metadata"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELDS_AVOID_DISCOURAGED_TYPES", `Field "Status.cause" should avoid google.protobuf.Any`),
+				report.Failuref(pos, "FIELDS_AVOID_DISCOURAGED_TYPES", `Field "Status.payload" should avoid bytes`),
+			},
+		},
+		{
+			name: "an error for the invalid pattern",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{},
+			},
+			inputAllowedFields: []string{"Status.[details"},
+			wantExistErr:       true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldsAvoidDiscouragedTypesRule(
+				test.inputTypes,
+				test.inputAllowedFields,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -38,6 +39,26 @@ func (r FieldsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r FieldsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment on each field documents its meaning, units and constraints for the API consumers.",
+		BadExample: `message Book {
+  string title = 1;
+}`,
+		GoodExample: `message Book {
+  // title is the title of the book.
+  string title = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the field. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldsHaveCommentVisitor{
@@ -0,0 +1,242 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+var (
+	defaultTimestampSuffixes = []string{
+		"_time",
+		"_at",
+		"_timestamp",
+	}
+	defaultDurationSuffixes = []string{
+		"_duration",
+		"_timeout",
+		"_ttl",
+		"_interval",
+		"_delay",
+		"_latency",
+		"_elapsed",
+	}
+	defaultUnitSuffixes = []string{
+		"_ms",
+		"_millis",
+		"_milliseconds",
+		"_sec",
+		"_secs",
+		"_seconds",
+		"_us",
+		"_micros",
+		"_microseconds",
+		"_ns",
+		"_nanos",
+		"_nanoseconds",
+		"_minutes",
+		"_hours",
+	}
+	defaultFieldMaskSuffixes = []string{
+		"_mask",
+	}
+)
+
+// FieldsPreferWellKnownTypesRule verifies that the fields use the well-known types (e.g. "google.protobuf.Timestamp")
+// instead of the scalar types, based on the field names.
+type FieldsPreferWellKnownTypesRule struct {
+	timestampSuffixes []string
+	durationSuffixes  []string
+	unitSuffixes      []string
+	fieldMaskSuffixes []string
+}
+
+// NewFieldsPreferWellKnownTypesRule creates a new FieldsPreferWellKnownTypesRule.
+func NewFieldsPreferWellKnownTypesRule(
+	timestampSuffixes []string,
+	durationSuffixes []string,
+	unitSuffixes []string,
+	fieldMaskSuffixes []string,
+) FieldsPreferWellKnownTypesRule {
+	if len(timestampSuffixes) == 0 {
+		timestampSuffixes = defaultTimestampSuffixes
+	}
+	if len(durationSuffixes) == 0 {
+		durationSuffixes = defaultDurationSuffixes
+	}
+	if len(unitSuffixes) == 0 {
+		unitSuffixes = defaultUnitSuffixes
+	}
+	if len(fieldMaskSuffixes) == 0 {
+		fieldMaskSuffixes = defaultFieldMaskSuffixes
+	}
+	return FieldsPreferWellKnownTypesRule{
+		timestampSuffixes: timestampSuffixes,
+		durationSuffixes:  durationSuffixes,
+		unitSuffixes:      unitSuffixes,
+		fieldMaskSuffixes: fieldMaskSuffixes,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r FieldsPreferWellKnownTypesRule) ID() string {
+	return "FIELDS_PREFER_WELL_KNOWN_TYPES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FieldsPreferWellKnownTypesRule) Purpose() string {
+	return `Verifies that the fields use the well-known types (e.g. "google.protobuf.Timestamp") instead of the scalar types.`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FieldsPreferWellKnownTypesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FieldsPreferWellKnownTypesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A scalar leaves the unit, the epoch and the presence to a comment. The well-known types carry them in the type and map to the native types of each language.",
+		BadExample: `message Book {
+  int64 created_at_millis = 1;
+  string duration = 2;
+  bool has_pages = 3;
+  int32 pages = 4;
+}`,
+		GoodExample: `message Book {
+  google.protobuf.Timestamp create_time = 1;
+  google.protobuf.Duration duration = 2;
+  google.protobuf.Int32Value pages = 3;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "timestamp_suffixes",
+				Description: "The field name suffixes suggesting google.protobuf.Timestamp. The default is _time, _at and _timestamp.",
+			},
+			{
+				Name:        "duration_suffixes",
+				Description: "The field name suffixes suggesting google.protobuf.Duration, e.g. _duration and _timeout.",
+			},
+			{
+				Name:        "unit_suffixes",
+				Description: "The time unit suffixes, e.g. _ms and _seconds. They are trimmed before the other suffixes are matched, and a number with them otherwise suggests google.protobuf.Duration.",
+			},
+			{
+				Name:        "field_mask_suffixes",
+				Description: "The field name suffixes suggesting google.protobuf.FieldMask. The default is _mask.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FieldsPreferWellKnownTypesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fieldsPreferWellKnownTypesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		rule:           r,
+		presenceFields: make(map[*parser.Field]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fieldsPreferWellKnownTypesVisitor struct {
+	*visitor.BaseAddVisitor
+	rule           FieldsPreferWellKnownTypesRule
+	presenceFields map[*parser.Field]string
+}
+
+// VisitMessage collects the fields with the presence fields like has_xxx.
+func (v *fieldsPreferWellKnownTypesVisitor) VisitMessage(message *parser.Message) bool {
+	v.collectPresenceFields(message.MessageBody)
+	return true
+}
+
+// VisitGroupField collects the fields with the presence fields like has_xxx.
+func (v *fieldsPreferWellKnownTypesVisitor) VisitGroupField(group *parser.GroupField) bool {
+	v.collectPresenceFields(group.MessageBody)
+	return true
+}
+
+func (v *fieldsPreferWellKnownTypesVisitor) collectPresenceFields(body []parser.Visitee) {
+	presences := make(map[string]string)
+	for _, element := range body {
+		if f, ok := element.(*parser.Field); ok && f.Type == "bool" && !f.IsRepeated && strings.HasPrefix(f.FieldName, "has_") {
+			presences[strings.TrimPrefix(f.FieldName, "has_")] = f.FieldName
+		}
+	}
+	for _, element := range body {
+		f, ok := element.(*parser.Field)
+		if !ok || f.IsRepeated {
+			continue
+		}
+		if presence, ok := presences[f.FieldName]; ok {
+			v.presenceFields[f] = presence
+		}
+	}
+}
+
+// VisitField checks the field.
+func (v *fieldsPreferWellKnownTypesVisitor) VisitField(field *parser.Field) bool {
+	if presence, ok := v.presenceFields[field]; ok {
+		if wrapper, ok := wrapperTypes[field.Type]; ok {
+			v.AddFailuref(
+				field.Meta.Pos,
+				"Field %q with the presence field %q should be %s or optional instead of %s",
+				field.FieldName,
+				presence,
+				wrapper,
+				field.Type,
+			)
+			return false
+		}
+	}
+	v.check(field.FieldName, field.Type, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *fieldsPreferWellKnownTypesVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field.FieldName, field.Type, field.Meta.Pos)
+	return false
+}
+
+func (v *fieldsPreferWellKnownTypesVisitor) check(fieldName, typ string, pos meta.Position) {
+	if want := v.rule.suggest(fieldName, typ); want != "" {
+		v.AddFailuref(pos, "Field %q should be %s instead of %s", fieldName, want, typ)
+	}
+}
+
+// suggest returns the well-known type for the field. It returns empty if there is none.
+func (r FieldsPreferWellKnownTypesRule) suggest(fieldName, typ string) string {
+	if typ == "string" && hasNameSuffix(fieldName, r.fieldMaskSuffixes) {
+		return "google.protobuf.FieldMask"
+	}
+	if !isNumericType(typ) && typ != "string" {
+		return ""
+	}
+
+	name := fieldName
+	var hasUnit bool
+	for _, suffix := range r.unitSuffixes {
+		if strings.HasSuffix(name, suffix) && name != suffix {
+			name = strings.TrimSuffix(name, suffix)
+			hasUnit = true
+			break
+		}
+	}
+
+	switch {
+	case hasNameSuffix(name, r.timestampSuffixes):
+		return "google.protobuf.Timestamp"
+	case hasNameSuffix(name, r.durationSuffixes):
+		return "google.protobuf.Duration"
+	case hasUnit && isNumericType(typ):
+		return "google.protobuf.Duration"
+	}
+	return ""
+}
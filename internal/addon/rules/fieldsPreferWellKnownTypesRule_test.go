@@ -0,0 +1,153 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFieldsPreferWellKnownTypesRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newField := func(typ, name string) *parser.Field {
+		return &parser.Field{
+			Type:      typ,
+			FieldName: name,
+			Meta:      meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		inputProto             *parser.Proto
+		inputTimestampSuffixes []string
+		inputDurationSuffixes  []string
+		inputUnitSuffixes      []string
+		inputFieldMaskSuffixes []string
+		wantFailures           []report.Failure
+	}{
+		{
+			name: "no failures for proto without fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with the well-known types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("google.protobuf.Timestamp", "create_time"),
+							newField("google.protobuf.Duration", "duration"),
+							newField("google.protobuf.FieldMask", "update_mask"),
+							newField("string", "format"),
+							newField("int32", "page_size"),
+							newField("bool", "has_pages"),
+							newField("google.protobuf.Int32Value", "pages"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the scalar types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("int64", "created_at_millis"),
+							newField("string", "duration"),
+							newField("int32", "timeout_ms"),
+							newField("uint64", "elapsed_nanos"),
+							newField("string", "update_mask"),
+							newField("bool", "has_pages"),
+							newField("int32", "pages"),
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										Type:      "int64",
+										FieldName: "expire_time",
+										Meta:      meta.Meta{Pos: pos},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "created_at_millis" should be google.protobuf.Timestamp instead of int64`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "duration" should be google.protobuf.Duration instead of string`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "timeout_ms" should be google.protobuf.Duration instead of int32`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "elapsed_nanos" should be google.protobuf.Duration instead of uint64`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "update_mask" should be google.protobuf.FieldMask instead of string`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "pages" with the presence field "has_pages" should be google.protobuf.Int32Value or optional instead of int32`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "expire_time" should be google.protobuf.Timestamp instead of int64`),
+			},
+		},
+		{
+			name: "failures for proto with the custom suffixes",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							newField("int64", "created_at"),
+							newField("int64", "published_on"),
+							newField("int32", "retry_period"),
+							newField("int32", "wait_ms"),
+							newField("int32", "wait_ticks"),
+							newField("string", "read_paths"),
+						},
+					},
+				},
+			},
+			inputTimestampSuffixes: []string{"_on"},
+			inputDurationSuffixes:  []string{"_period"},
+			inputUnitSuffixes:      []string{"_ticks"},
+			inputFieldMaskSuffixes: []string{"_paths"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "published_on" should be google.protobuf.Timestamp instead of int64`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "retry_period" should be google.protobuf.Duration instead of int32`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "wait_ticks" should be google.protobuf.Duration instead of int32`),
+				report.Failuref(pos, "FIELDS_PREFER_WELL_KNOWN_TYPES", `Field "read_paths" should be google.protobuf.FieldMask instead of string`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFieldsPreferWellKnownTypesRule(
+				test.inputTimestampSuffixes,
+				test.inputDurationSuffixes,
+				test.inputUnitSuffixes,
+				test.inputFieldMaskSuffixes,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
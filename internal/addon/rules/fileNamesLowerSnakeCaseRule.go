@@ -9,6 +9,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -43,6 +44,22 @@ func (r FileNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r FileNamesLowerSnakeCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The style guide uses lower_snake_case.proto for file names. It avoids the conflicts on case-insensitive file systems.",
+		BadExample:    "BookService.proto",
+		GoodExample:   "book_service.proto",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#file-structure",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "excludes",
+				Description: "The file paths to allow regardless of their names.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FileNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fileNamesLowerSnakeCaseVisitor{
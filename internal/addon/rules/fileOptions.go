@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// defaultLanguageOptions are the file options which are the same among the files in a package.
+// java_outer_classname is not included because it differs by the file.
+var defaultLanguageOptions = []string{
+	"go_package",
+	"java_package",
+	"java_multiple_files",
+	"csharp_namespace",
+	"objc_class_prefix",
+	"php_namespace",
+	"ruby_package",
+	"swift_prefix",
+}
+
+// protoPackageName returns the package of the proto. It returns empty if the proto has no package.
+func protoPackageName(proto *parser.Proto) string {
+	for _, element := range proto.ProtoBody {
+		if p, ok := element.(*parser.Package); ok {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// fileOptions returns the file options keyed by the option names.
+func fileOptions(proto *parser.Proto) map[string]*parser.Option {
+	options := make(map[string]*parser.Option)
+	for _, element := range proto.ProtoBody {
+		if option, ok := element.(*parser.Option); ok {
+			options[option.OptionName] = option
+		}
+	}
+	return options
+}
+
+var versionSegment = regexp.MustCompile(`^v\d+((alpha|beta)\d*)?$`)
+
+// expandFileOptionTemplate expands the placeholders in the template with the package.
+//
+//   - {package} is the package, e.g. acme.library.v1.
+//   - {package_path} is the package separated by slashes, e.g. acme/library/v1.
+//   - {package_name} is the last segment except the version, e.g. library.
+//   - {package_version} is the version segment, e.g. v1. It is empty if the package has no version.
+func expandFileOptionTemplate(template string, pkg string) string {
+	segments := strings.Split(pkg, ".")
+	var name, version string
+	if last := segments[len(segments)-1]; versionSegment.MatchString(last) && 1 < len(segments) {
+		name = segments[len(segments)-2]
+		version = last
+	} else {
+		name = last
+	}
+
+	return strings.NewReplacer(
+		"{package}", pkg,
+		"{package_path}", strings.Join(segments, "/"),
+		"{package_name}", name,
+		"{package_version}", version,
+	).Replace(template)
+}
+
+// compileFileOptionTemplate compiles the expanded template into the regexp matching the values.
+// "*" in the template matches any characters.
+func compileFileOptionTemplate(expanded string) *regexp.Regexp {
+	parts := strings.Split(expanded, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
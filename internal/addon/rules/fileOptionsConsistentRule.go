@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FileOptionsConsistentRule verifies that the file options are consistent across the files with the same package.
+// The first file in the linted set having the option is the reference of the others.
+type FileOptionsConsistentRule struct {
+	options  []string
+	protoSet *file.ParsedProtoSet
+}
+
+// NewFileOptionsConsistentRule creates a new FileOptionsConsistentRule.
+// The files with the same package are looked up from protoSet.
+func NewFileOptionsConsistentRule(
+	options []string,
+	protoSet *file.ParsedProtoSet,
+) FileOptionsConsistentRule {
+	if len(options) == 0 {
+		options = defaultLanguageOptions
+	}
+	return FileOptionsConsistentRule{
+		options:  options,
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r FileOptionsConsistentRule) ID() string {
+	return "FILE_OPTIONS_CONSISTENT"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FileOptionsConsistentRule) Purpose() string {
+	return "Verifies that the file options are consistent across the files with the same package."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FileOptionsConsistentRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FileOptionsConsistentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The files with the same package generate the code into the same package of each language. An inconsistent option splits it or fails the build.",
+		BadExample: `// book.proto
+package acme.library.v1;
+option java_package = "com.acme.library.v1";
+
+// shelf.proto
+package acme.library.v1;
+option java_package = "com.acme.library";`,
+		GoodExample: `// book.proto
+package acme.library.v1;
+option java_package = "com.acme.library.v1";
+
+// shelf.proto
+package acme.library.v1;
+option java_package = "com.acme.library.v1";`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "options",
+				Description: "The file options to verify. The default is the language options except java_outer_classname, e.g. go_package and java_package.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FileOptionsConsistentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	pkg := protoPackageName(proto)
+	references := make(map[string]fileOptionReference)
+	if pkg != "" {
+		for _, other := range r.protoSet.Protos() {
+			if protoPackageName(other) != pkg {
+				continue
+			}
+			for name, option := range fileOptions(other) {
+				if _, ok := references[name]; !ok {
+					references[name] = fileOptionReference{
+						option:   option,
+						filename: other.Meta.Filename,
+					}
+				}
+			}
+		}
+	}
+
+	v := &fileOptionsConsistentVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		options:        r.options,
+		fileOptions:    fileOptions(proto),
+		filename:       proto.Meta.Filename,
+		pkg:            pkg,
+		references:     references,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fileOptionReference struct {
+	option   *parser.Option
+	filename string
+}
+
+type fileOptionsConsistentVisitor struct {
+	*visitor.BaseAddVisitor
+	options     []string
+	fileOptions map[string]*parser.Option
+	filename    string
+	pkg         string
+	references  map[string]fileOptionReference
+}
+
+// VisitOption checks the file option.
+func (v *fileOptionsConsistentVisitor) VisitOption(option *parser.Option) bool {
+	if v.fileOptions[option.OptionName] != option || !stringsutil.ContainsStringInSlice(option.OptionName, v.options) {
+		return false
+	}
+	reference, ok := v.references[option.OptionName]
+	if !ok || reference.filename == v.filename || reference.option.Constant == option.Constant {
+		return false
+	}
+	v.AddFailuref(
+		option.Meta.Pos,
+		"File option %q is %s, but %s in %q with the same package %q",
+		option.OptionName,
+		option.Constant,
+		reference.option.Constant,
+		reference.filename,
+		v.pkg,
+	)
+	return false
+}
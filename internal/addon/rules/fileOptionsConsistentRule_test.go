@@ -0,0 +1,93 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFileOptionsConsistentRule_Apply(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("", "rules", "fileOptions", "invalid.proto"),
+		setting_test.TestProtoFile("", "rules", "fileOptions", "book.proto"),
+		setting_test.TestProtoFile("", "rules", "fileOptions", "shelf.proto"),
+		setting_test.TestProtoFile("", "rules", "fileOptions", "store.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFilename string
+		inputOptions  []string
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:          "no failures for the first file of the package",
+			inputFilename: "book.proto",
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "no failures for the file without the other files of the package",
+			inputFilename: "store.proto",
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "no failures without the linted set",
+			inputFilename: "shelf.proto",
+		},
+		{
+			name:          "no failures for the file with the inconsistent option not specified",
+			inputFilename: "shelf.proto",
+			inputOptions:  []string{"java_package"},
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "failures for the file with the inconsistent option",
+			inputFilename: "shelf.proto",
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "shelf.proto",
+						Offset:   46,
+						Line:     5,
+						Column:   1,
+					},
+					"FILE_OPTIONS_CONSISTENT",
+					`File option "go_package" is "github.com/acme/apis/library;library", but "github.com/acme/apis/library/v1;libraryv1" in "book.proto" with the same package "acme.library.v1"`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFileOptionsConsistentRule(
+				test.inputOptions,
+				test.inputProtoSet,
+			)
+
+			proto, err := setting_test.TestProtoFile("", "rules", "fileOptions", test.inputFilename).Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// FileOptionsMatchTemplateRule verifies that the file options match the templates derived from the package.
+type FileOptionsMatchTemplateRule struct {
+	templates map[string]string
+}
+
+// NewFileOptionsMatchTemplateRule creates a new FileOptionsMatchTemplateRule.
+func NewFileOptionsMatchTemplateRule(
+	templates map[string]string,
+) FileOptionsMatchTemplateRule {
+	return FileOptionsMatchTemplateRule{
+		templates: templates,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r FileOptionsMatchTemplateRule) ID() string {
+	return "FILE_OPTIONS_MATCH_TEMPLATE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FileOptionsMatchTemplateRule) Purpose() string {
+	return "Verifies that the file options match the templates derived from the package."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FileOptionsMatchTemplateRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FileOptionsMatchTemplateRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Deriving the language options from the package keeps the generated code laid out the same way for every package.",
+		BadExample: `// java_package: com.acme.{package}
+package library.v1;
+option java_package = "com.example.library";`,
+		GoodExample: `// java_package: com.acme.{package}
+package library.v1;
+option java_package = "com.acme.library.v1";`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "templates",
+				Description: `The templates keyed by the file option names, e.g. go_package: "*;{package_name}{package_version}". The placeholders are {package}, {package_path}, {package_name} and {package_version}, and "*" matches any characters. Nothing is verified by default. The missing options are verified by FILE_OPTIONS_REQUIRED.`,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FileOptionsMatchTemplateRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	// The templates are expanded with the package of the file, and each of them is compiled once.
+	templates := make(map[string]fileOptionTemplate)
+	if pkg := protoPackageName(proto); pkg != "" {
+		for name, template := range r.templates {
+			expanded := expandFileOptionTemplate(template, pkg)
+			templates[name] = fileOptionTemplate{
+				expanded: expanded,
+				pattern:  compileFileOptionTemplate(expanded),
+			}
+		}
+	}
+
+	v := &fileOptionsMatchTemplateVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		templates:      templates,
+		fileOptions:    fileOptions(proto),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+// fileOptionTemplate represents the template expanded with the package.
+type fileOptionTemplate struct {
+	expanded string
+	pattern  *regexp.Regexp
+}
+
+type fileOptionsMatchTemplateVisitor struct {
+	*visitor.BaseAddVisitor
+	templates   map[string]fileOptionTemplate
+	fileOptions map[string]*parser.Option
+}
+
+// VisitOption checks the file option.
+func (v *fileOptionsMatchTemplateVisitor) VisitOption(option *parser.Option) bool {
+	template, ok := v.templates[option.OptionName]
+	if !ok || v.fileOptions[option.OptionName] != option {
+		return false
+	}
+
+	if !template.pattern.MatchString(unquote(option.Constant)) {
+		v.AddFailuref(option.Meta.Pos, "File option %q is %s, but it should match %q", option.OptionName, option.Constant, template.expanded)
+	}
+	return false
+}
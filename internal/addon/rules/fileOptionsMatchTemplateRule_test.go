@@ -0,0 +1,141 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFileOptionsMatchTemplateRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   1,
+	}
+
+	templates := map[string]string{
+		"go_package":       "*;{package_name}{package_version}",
+		"java_package":     "com.acme.{package}",
+		"csharp_namespace": "Acme.*",
+		"php_namespace":    "{package_path}",
+	}
+
+	tests := []struct {
+		name           string
+		inputProto     *parser.Proto
+		inputTemplates map[string]string
+		wantFailures   []report.Failure
+	}{
+		{
+			name: "no failures for proto with the options matching the templates",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library/v1;libraryv1"`,
+					},
+					&parser.Option{
+						OptionName: "java_package",
+						Constant:   `"com.acme.acme.library.v1"`,
+					},
+					&parser.Option{
+						OptionName: "csharp_namespace",
+						Constant:   `"Acme.Library.V1"`,
+					},
+					&parser.Option{
+						OptionName: "php_namespace",
+						Constant:   `"acme/library/v1"`,
+					},
+				},
+			},
+			inputTemplates: templates,
+		},
+		{
+			name: "no failures for proto with the package without version",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "library",
+					},
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library;library"`,
+					},
+				},
+			},
+			inputTemplates: templates,
+		},
+		{
+			name: "no failures without the templates",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library;library"`,
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the options not matching the templates",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1beta1",
+					},
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library/v1beta1;library"`,
+						Meta:       meta.Meta{Pos: pos},
+					},
+					&parser.Option{
+						OptionName: "java_package",
+						Constant:   `"com.example.library"`,
+						Meta:       meta.Meta{Pos: pos},
+					},
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "java_package",
+								Constant:   `"com.example.library"`,
+							},
+						},
+					},
+				},
+			},
+			inputTemplates: templates,
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FILE_OPTIONS_MATCH_TEMPLATE", `File option "go_package" is "github.com/acme/apis/library/v1beta1;library", but it should match "*;libraryv1beta1"`),
+				report.Failuref(pos, "FILE_OPTIONS_MATCH_TEMPLATE", `File option "java_package" is "com.example.library", but it should match "com.acme.acme.library.v1beta1"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFileOptionsMatchTemplateRule(test.inputTemplates)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
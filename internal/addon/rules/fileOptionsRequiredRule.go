@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// defaultRequiredFileOptions are the file options a file must have by default.
+var defaultRequiredFileOptions = []string{
+	"go_package",
+	"java_package",
+}
+
+// FileOptionsRequiredRule verifies that the specific file options are present (e.g. "go_package", "java_package").
+type FileOptionsRequiredRule struct {
+	options []string
+}
+
+// NewFileOptionsRequiredRule creates a new FileOptionsRequiredRule.
+func NewFileOptionsRequiredRule(
+	options []string,
+) FileOptionsRequiredRule {
+	if len(options) == 0 {
+		options = defaultRequiredFileOptions
+	}
+	return FileOptionsRequiredRule{
+		options: options,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r FileOptionsRequiredRule) ID() string {
+	return "FILE_OPTIONS_REQUIRED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r FileOptionsRequiredRule) Purpose() string {
+	return `Verifies that the specific file options are present (e.g. "go_package", "java_package").`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r FileOptionsRequiredRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r FileOptionsRequiredRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Without the language options, each code generator falls back to its own default, and the generated code lands in an unexpected package.",
+		BadExample: `syntax = "proto3";
+package acme.library.v1;`,
+		GoodExample: `syntax = "proto3";
+package acme.library.v1;
+option go_package = "github.com/acme/apis/library/v1;libraryv1";
+option java_package = "com.acme.library.v1";`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "options",
+				Description: "The file options to require. The default is go_package and java_package.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r FileOptionsRequiredRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &fileOptionsRequiredVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		options:        r.options,
+		present:        fileOptions(proto),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type fileOptionsRequiredVisitor struct {
+	*visitor.BaseAddVisitor
+	options []string
+	present map[string]*parser.Option
+}
+
+// VisitSyntax checks the file options.
+func (v *fileOptionsRequiredVisitor) VisitSyntax(s *parser.Syntax) bool {
+	for _, option := range v.options {
+		if _, ok := v.present[option]; !ok {
+			v.AddFailuref(s.Meta.Pos, "File option %q is required", option)
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestFileOptionsRequiredRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   0,
+		Line:     1,
+		Column:   1,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		inputOptions []string
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the required options",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+					Meta:            meta.Meta{Pos: pos},
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library/v1;libraryv1"`,
+					},
+					&parser.Option{
+						OptionName: "java_package",
+						Constant:   `"com.acme.library.v1"`,
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto without the required options",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+					Meta:            meta.Meta{Pos: pos},
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library/v1;libraryv1"`,
+					},
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "java_package",
+								Constant:   `"com.acme.library.v1"`,
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FILE_OPTIONS_REQUIRED", `File option "java_package" is required`),
+			},
+		},
+		{
+			name: "failures for proto without the specified options",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+					Meta:            meta.Meta{Pos: pos},
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Option{
+						OptionName: "go_package",
+						Constant:   `"github.com/acme/apis/library/v1;libraryv1"`,
+					},
+				},
+			},
+			inputOptions: []string{"go_package", "csharp_namespace"},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "FILE_OPTIONS_REQUIRED", `File option "csharp_namespace" is required`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewFileOptionsRequiredRule(test.inputOptions)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
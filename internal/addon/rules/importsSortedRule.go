@@ -7,6 +7,7 @@ import (
 
 	"github.com/tyhal/protolint/internal/osutil"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -45,6 +46,29 @@ func (r ImportsSortedRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r ImportsSortedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Sorted imports are easy to scan and produce smaller diffs. Run with -fix to sort them automatically.",
+		BadExample: `import "google/protobuf/timestamp.proto";
+import "google/protobuf/empty.proto";`,
+		GoodExample: `import "google/protobuf/empty.proto";
+import "google/protobuf/timestamp.proto";`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#file-structure",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "newline",
+				Description: `The newline of the files. The default is "\n".`,
+			},
+		},
+	}
+}
+
+// IsFixable decides whether or not this rule can fix the problems with the -fix flag.
+func (r ImportsSortedRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r ImportsSortedRule) Apply(
 	proto *parser.Proto,
@@ -10,6 +10,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -63,6 +64,35 @@ func (r IndentRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r IndentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A consistent indentation makes the nesting of messages and services obvious. Run with -fix to reindent automatically.",
+		BadExample: `message Book {
+    string title = 1;
+}`,
+		GoodExample: `message Book {
+  string title = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#standard-file-formatting",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "style",
+				Description: "The indent style, tab, 4 or 2. The default is 2 spaces.",
+			},
+			{
+				Name:        "newline",
+				Description: `The newline of the files. The default is "\n".`,
+			},
+		},
+	}
+}
+
+// IsFixable decides whether or not this rule can fix the problems with the -fix flag.
+func (r IndentRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r IndentRule) Apply(
 	proto *parser.Proto,
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/strs"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// MapFieldNamesPluralizedRule verifies that map field names are pluralized names.
+type MapFieldNamesPluralizedRule struct {
+	pluralRules      map[string]string
+	singularRules    map[string]string
+	uncountableRules []string
+	irregularRules   map[string]string
+}
+
+// NewMapFieldNamesPluralizedRule creates a new MapFieldNamesPluralizedRule.
+func NewMapFieldNamesPluralizedRule(
+	pluralRules map[string]string,
+	singularRules map[string]string,
+	uncountableRules []string,
+	irregularRules map[string]string,
+) MapFieldNamesPluralizedRule {
+	return MapFieldNamesPluralizedRule{
+		pluralRules:      pluralRules,
+		singularRules:    singularRules,
+		uncountableRules: uncountableRules,
+		irregularRules:   irregularRules,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MapFieldNamesPluralizedRule) ID() string {
+	return "MAP_FIELD_NAMES_PLURALIZED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MapFieldNamesPluralizedRule) Purpose() string {
+	return "Verifies that map field names are pluralized names."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MapFieldNamesPluralizedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MapFieldNamesPluralizedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A map holds many values like a repeated field. A plural name tells that as it does for the repeated fields.",
+		BadExample: `message Library {
+  map<string, Book> book = 1;
+}`,
+		GoodExample: `message Library {
+  map<string, Book> books = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "plural_rules",
+				Description: "The additional rules to pluralize words.",
+			},
+			{
+				Name:        "singular_rules",
+				Description: "The additional rules to singularize words.",
+			},
+			{
+				Name:        "uncountable_rules",
+				Description: "The additional uncountable words.",
+			},
+			{
+				Name:        "irregular_rules",
+				Description: "The additional irregular words, the singular as the key and the plural as the value.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MapFieldNamesPluralizedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &mapFieldNamesPluralizedVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		pluralizeClient: newPluralizeClient(
+			r.pluralRules,
+			r.singularRules,
+			r.uncountableRules,
+			r.irregularRules,
+		),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type mapFieldNamesPluralizedVisitor struct {
+	*visitor.BaseAddVisitor
+	pluralizeClient *strs.PluralizeClient
+}
+
+// VisitMapField checks the map field.
+func (v *mapFieldNamesPluralizedVisitor) VisitMapField(field *parser.MapField) bool {
+	got := field.MapName
+	want := v.pluralizeClient.ToPlural(got)
+	if got != want {
+		v.AddFailuref(field.Meta.Pos, "Map field name %q must be pluralized name %q", got, want)
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMapFieldNamesPluralizedRule_Apply(t *testing.T) {
+	tests := []struct {
+		name             string
+		pluralRules      map[string]string
+		singularRules    map[string]string
+		uncountableRules []string
+		irregularRules   map[string]string
+		inputProto       *parser.Proto
+		wantFailures     []report.Failure
+	}{
+		{
+			name: "no failures for proto without map fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "singer",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with valid map field names",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								MapName: "singers",
+							},
+							&parser.MapField{
+								MapName: "people",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with map field names by applying some customization",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								MapName: "paper",
+							},
+							&parser.MapField{
+								MapName: "regular",
+							},
+						},
+					},
+				},
+			},
+			uncountableRules: []string{"paper"},
+			irregularRules: map[string]string{
+				"irregular": "regular",
+			},
+		},
+		{
+			name: "failures for proto with invalid map field names",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								MapName: "singer",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+							&parser.MapField{
+								MapName: "person",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   200,
+										Line:     10,
+										Column:   20,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"MAP_FIELD_NAMES_PLURALIZED",
+					`Map field name "singer" must be pluralized name "singers"`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"MAP_FIELD_NAMES_PLURALIZED",
+					`Map field name "person" must be pluralized name "people"`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMapFieldNamesPluralizedRule(
+				test.pluralRules,
+				test.singularRules,
+				test.uncountableRules,
+				test.irregularRules,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// mapKeyTypes are the types which the map keys can be.
+var mapKeyTypes = []string{
+	"string",
+	"bool",
+	"int32",
+	"int64",
+	"uint32",
+	"uint64",
+	"sint32",
+	"sint64",
+	"fixed32",
+	"fixed64",
+	"sfixed32",
+	"sfixed64",
+}
+
+// defaultMapKeyTypes are the key types allowed by default.
+// They leave out bool, and the fixed and the zigzag encodings of the integers which rarely make good keys.
+var defaultMapKeyTypes = []string{
+	"string",
+	"int32",
+	"int64",
+	"uint32",
+	"uint64",
+}
+
+// MapKeyTypesRule verifies that the key types of all map fields are in the allowed types.
+type MapKeyTypesRule struct {
+	types []string
+}
+
+// NewMapKeyTypesRule creates a new MapKeyTypesRule.
+// The default types are used if types is empty.
+func NewMapKeyTypesRule(
+	types []string,
+) MapKeyTypesRule {
+	if len(types) == 0 {
+		types = defaultMapKeyTypes
+	}
+	return MapKeyTypesRule{
+		types: types,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MapKeyTypesRule) ID() string {
+	return "MAP_KEY_TYPES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MapKeyTypesRule) Purpose() string {
+	return "Verifies that the key types of all map fields are in the allowed types."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MapKeyTypesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MapKeyTypesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Some key types map poorly to the languages and the JSON mapping, where all the keys become strings. Restricting them keeps the maps portable.",
+		BadExample: `message Library {
+  map<bool, Book> books = 1;
+}`,
+		GoodExample: `message Library {
+  map<string, Book> books = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "types",
+				Description: "The allowed key types. The default is " + strings.Join(defaultMapKeyTypes, ", ") + ".",
+				Values:      mapKeyTypes,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MapKeyTypesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &mapKeyTypesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		types:          r.types,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type mapKeyTypesVisitor struct {
+	*visitor.BaseAddVisitor
+	types []string
+}
+
+// VisitMapField checks the map field.
+func (v *mapKeyTypesVisitor) VisitMapField(field *parser.MapField) bool {
+	if !stringsutil.ContainsStringInSlice(field.KeyType, v.types) {
+		v.AddFailuref(
+			field.Meta.Pos,
+			"Map field %q has the key type %q, but it must be one of %s",
+			field.MapName, field.KeyType, strings.Join(v.types, ", "),
+		)
+	}
+	return false
+}
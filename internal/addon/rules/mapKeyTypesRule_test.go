@@ -0,0 +1,115 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMapKeyTypesRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputTypes   []string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the default key types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								KeyType: "string",
+								MapName: "labels",
+							},
+							&parser.MapField{
+								KeyType: "uint64",
+								MapName: "books",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the key types out of the default",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								KeyType: "bool",
+								MapName: "flags",
+								Meta:    meta.Meta{Pos: pos},
+							},
+							&parser.MapField{
+								KeyType: "int32",
+								MapName: "books",
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAP_KEY_TYPES", `Map field "flags" has the key type "bool", but it must be one of string, int32, int64, uint32, uint64`),
+			},
+		},
+		{
+			name:       "failures for proto with the key types out of the configured types",
+			inputTypes: []string{"string"},
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.MapField{
+								KeyType: "string",
+								MapName: "labels",
+							},
+							&parser.Message{
+								MessageBody: []parser.Visitee{
+									&parser.MapField{
+										KeyType: "int64",
+										MapName: "books",
+										Meta:    meta.Meta{Pos: pos},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAP_KEY_TYPES", `Map field "books" has the key type "int64", but it must be one of string`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMapKeyTypesRule(test.inputTypes)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultMaxEnumValues = 100
+
+// MaxEnumValuesRule enforces a maximum number of values in an enum.
+type MaxEnumValuesRule struct {
+	maxValues int
+}
+
+// NewMaxEnumValuesRule creates a new MaxEnumValuesRule.
+func NewMaxEnumValuesRule(
+	maxValues int,
+) MaxEnumValuesRule {
+	if maxValues == 0 {
+		maxValues = defaultMaxEnumValues
+	}
+	return MaxEnumValuesRule{
+		maxValues: maxValues,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxEnumValuesRule) ID() string {
+	return "MAX_ENUM_VALUES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxEnumValuesRule) Purpose() string {
+	return "Enforces a maximum number of values in an enum."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxEnumValuesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxEnumValuesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "An enum with too many values is usually a list of data rather than a set of states. The data changes more often than the API should.",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_values",
+				Description: "The maximum number of values in an enum. The default is 100.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxEnumValuesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &maxEnumValuesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		maxValues:      r.maxValues,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type maxEnumValuesVisitor struct {
+	*visitor.BaseAddVisitor
+	maxValues int
+}
+
+// VisitEnum checks the enum.
+func (v *maxEnumValuesVisitor) VisitEnum(enum *parser.Enum) bool {
+	if count := countEnumValues(enum.EnumBody); v.maxValues < count {
+		v.AddFailuref(
+			enum.Meta.Pos,
+			"Enum %q has %d values, but it must have at most %d",
+			enum.EnumName,
+			count,
+			v.maxValues,
+		)
+	}
+	return false
+}
+
+// countEnumValues returns the number of values in the enum body.
+func countEnumValues(body []parser.Visitee) int {
+	var count int
+	for _, element := range body {
+		if _, ok := element.(*parser.EnumField); ok {
+			count++
+		}
+	}
+	return count
+}
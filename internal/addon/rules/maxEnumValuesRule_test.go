@@ -0,0 +1,94 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxEnumValuesRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newEnum := func(name string, valueCount int) *parser.Enum {
+		var body []parser.Visitee
+		for i := 0; i < valueCount; i++ {
+			body = append(body, &parser.EnumField{})
+		}
+		body = append(body, &parser.Option{}, &parser.Reserved{})
+		return &parser.Enum{
+			EnumName: name,
+			EnumBody: body,
+			Meta:     meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		inputMaxValues int
+		inputProto     *parser.Proto
+		wantFailures   []report.Failure
+	}{
+		{
+			name: "no failures for proto with the enums within the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newEnum("Genre", 100),
+				},
+			},
+		},
+		{
+			name: "failures for proto with the enums over the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newEnum("Genre", 101),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_ENUM_VALUES", `Enum "Genre" has 101 values, but it must have at most 100`),
+			},
+		},
+		{
+			name:           "failures for proto with the enums over the maximum",
+			inputMaxValues: 2,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newEnum("Genre", 2),
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							newEnum("Format", 3),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_ENUM_VALUES", `Enum "Format" has 3 values, but it must have at most 2`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxEnumValuesRule(test.inputMaxValues)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
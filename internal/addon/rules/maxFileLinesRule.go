@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/disablerule"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+)
+
+const defaultMaxFileLines = 1000
+
+// MaxFileLinesRule enforces a maximum number of lines in a file.
+// The failure is reported at the first line over the maximum.
+type MaxFileLinesRule struct {
+	maxLines int
+}
+
+// NewMaxFileLinesRule creates a new MaxFileLinesRule.
+func NewMaxFileLinesRule(
+	maxLines int,
+) MaxFileLinesRule {
+	if maxLines == 0 {
+		maxLines = defaultMaxFileLines
+	}
+	return MaxFileLinesRule{
+		maxLines: maxLines,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxFileLinesRule) ID() string {
+	return "MAX_FILE_LINES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxFileLinesRule) Purpose() string {
+	return "Enforces a maximum number of lines in a file."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxFileLinesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxFileLinesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A long file generates a long source of every language, and collects the conflicts of the unrelated changes. Split it by the resources or the services.",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_lines",
+				Description: "The maximum number of lines in a file. The default is 1000.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxFileLinesRule) Apply(proto *parser.Proto) (
+	failures []report.Failure,
+	err error,
+) {
+	fileName := proto.Meta.Filename
+	reader, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		closeErr := reader.Close()
+		if err != nil {
+			return
+		}
+		if closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	disablerule.NewInterpreter(r.ID()).CallEachIfValid(
+		lines,
+		func(index int, _ string) {
+			if index == r.maxLines {
+				failures = append(failures, report.Failuref(
+					meta.Position{
+						Filename: fileName,
+						Line:     index + 1,
+						Column:   1,
+					},
+					r.ID(),
+					"The file has %d lines, but it must have at most %d",
+					len(lines),
+					r.maxLines,
+				))
+			}
+		},
+	)
+	return failures, nil
+}
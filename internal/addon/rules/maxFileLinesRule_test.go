@@ -0,0 +1,92 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxFileLinesRule_Apply(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputMaxLines int
+		inputProto    *parser.Proto
+		wantFailures  []report.Failure
+		wantExistErr  bool
+	}{
+		{
+			name: "not found proto file",
+			inputProto: &parser.Proto{
+				Meta: &parser.ProtoMeta{
+					Filename: "",
+				},
+			},
+			wantExistErr: true,
+		},
+		{
+			name: "not found long files with the default maximum",
+			inputProto: &parser.Proto{
+				Meta: &parser.ProtoMeta{
+					Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+				},
+			},
+		},
+		{
+			name:          "not found long files",
+			inputMaxLines: 29,
+			inputProto: &parser.Proto{
+				Meta: &parser.ProtoMeta{
+					Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+				},
+			},
+		},
+		{
+			name:          "found long files",
+			inputMaxLines: 20,
+			inputProto: &parser.Proto{
+				Meta: &parser.ProtoMeta{
+					Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Line:     21,
+						Column:   1,
+					},
+					"MAX_FILE_LINES",
+					"The file has 29 lines, but it must have at most 20",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxFileLinesRule(test.inputMaxLines)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/tyhal/protolint/linter/disablerule"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 )
 
 const (
@@ -60,6 +61,24 @@ func (r MaxLineLengthRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r MaxLineLengthRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "Long lines are hard to read in code reviews and terminals.",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#standard-file-formatting",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_chars",
+				Description: "The maximum number of characters in a line. The default is 80.",
+			},
+			{
+				Name:        "tab_chars",
+				Description: "The number of characters a tab counts as. The default is 4.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 	failures []report.Failure,
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultMaxMessageFields = 100
+
+// MaxMessageFieldsRule enforces a maximum number of fields in a message.
+// The fields in the oneofs and the groups count as the fields of the message.
+type MaxMessageFieldsRule struct {
+	maxFields int
+}
+
+// NewMaxMessageFieldsRule creates a new MaxMessageFieldsRule.
+func NewMaxMessageFieldsRule(
+	maxFields int,
+) MaxMessageFieldsRule {
+	if maxFields == 0 {
+		maxFields = defaultMaxMessageFields
+	}
+	return MaxMessageFieldsRule{
+		maxFields: maxFields,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxMessageFieldsRule) ID() string {
+	return "MAX_MESSAGE_FIELDS"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxMessageFieldsRule) Purpose() string {
+	return "Enforces a maximum number of fields in a message."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxMessageFieldsRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxMessageFieldsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A message with too many fields generates the classes and the methods exceeding the limits of some languages, like the 64KB method size of Java. Split it into the nested messages.",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_fields",
+				Description: "The maximum number of fields in a message. The default is 100.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxMessageFieldsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &maxMessageFieldsVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		maxFields:      r.maxFields,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type maxMessageFieldsVisitor struct {
+	*visitor.BaseAddVisitor
+	maxFields int
+}
+
+// VisitMessage checks the message.
+func (v *maxMessageFieldsVisitor) VisitMessage(message *parser.Message) bool {
+	if count := countMessageFields(message.MessageBody); v.maxFields < count {
+		v.AddFailuref(
+			message.Meta.Pos,
+			"Message %q has %d fields, but it must have at most %d",
+			message.MessageName,
+			count,
+			v.maxFields,
+		)
+	}
+	return true
+}
+
+// countMessageFields returns the number of fields in the message body including the oneof fields.
+func countMessageFields(body []parser.Visitee) int {
+	var count int
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Field, *parser.MapField, *parser.GroupField:
+			count++
+		case *parser.Oneof:
+			count += len(e.OneofFields)
+		}
+	}
+	return count
+}
@@ -0,0 +1,125 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxMessageFieldsRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newMessage := func(name string, fieldCount int) *parser.Message {
+		var body []parser.Visitee
+		for i := 0; i < fieldCount; i++ {
+			body = append(body, &parser.Field{})
+		}
+		return &parser.Message{
+			MessageName: name,
+			MessageBody: body,
+			Meta:        meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		inputMaxFields int
+		inputProto     *parser.Proto
+		wantFailures   []report.Failure
+	}{
+		{
+			name: "no failures for proto with the messages within the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newMessage("Book", 100),
+				},
+			},
+		},
+		{
+			name:           "no failures for proto with the messages within the maximum",
+			inputMaxFields: 3,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{},
+									{},
+								},
+							},
+							&parser.Message{},
+							&parser.Enum{},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the messages over the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newMessage("Book", 101),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_MESSAGE_FIELDS", `Message "Book" has 101 fields, but it must have at most 100`),
+			},
+		},
+		{
+			name:           "failures for proto with the messages over the maximum",
+			inputMaxFields: 2,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{},
+							&parser.MapField{},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{},
+								},
+							},
+							newMessage("Page", 3),
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_MESSAGE_FIELDS", `Message "Book" has 3 fields, but it must have at most 2`),
+				report.Failuref(pos, "MAX_MESSAGE_FIELDS", `Message "Page" has 3 fields, but it must have at most 2`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxMessageFieldsRule(test.inputMaxFields)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultMaxMessageNestingDepth = 3
+
+// MaxMessageNestingDepthRule enforces a maximum nesting depth of the messages.
+// The depth of a top-level message is 1, and the groups count as the nested messages.
+// The depth is reported at the top-level message.
+type MaxMessageNestingDepthRule struct {
+	maxDepth int
+}
+
+// NewMaxMessageNestingDepthRule creates a new MaxMessageNestingDepthRule.
+func NewMaxMessageNestingDepthRule(
+	maxDepth int,
+) MaxMessageNestingDepthRule {
+	if maxDepth == 0 {
+		maxDepth = defaultMaxMessageNestingDepth
+	}
+	return MaxMessageNestingDepthRule{
+		maxDepth: maxDepth,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxMessageNestingDepthRule) ID() string {
+	return "MAX_MESSAGE_NESTING_DEPTH"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxMessageNestingDepthRule) Purpose() string {
+	return "Enforces a maximum nesting depth of the messages."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxMessageNestingDepthRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxMessageNestingDepthRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The deeply nested messages generate the long type names like Outer_Middle_Inner_Innermost, and can't be reused from the other messages.",
+		BadExample: `message Library {
+  message Shelf {
+    message Book {
+      message Page {}
+    }
+  }
+}`,
+		GoodExample: `message Library {
+  message Shelf {}
+}
+
+message Book {
+  message Page {}
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_depth",
+				Description: "The maximum nesting depth of the messages. The depth of a top-level message is 1. The default is 3.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxMessageNestingDepthRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &maxMessageNestingDepthVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		maxDepth:       r.maxDepth,
+		topLevels:      make(map[*parser.Message]bool),
+	}
+	for _, element := range proto.ProtoBody {
+		if message, ok := element.(*parser.Message); ok {
+			v.topLevels[message] = true
+		}
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type maxMessageNestingDepthVisitor struct {
+	*visitor.BaseAddVisitor
+	maxDepth  int
+	topLevels map[*parser.Message]bool
+}
+
+// VisitMessage checks the top-level message.
+func (v *maxMessageNestingDepthVisitor) VisitMessage(message *parser.Message) bool {
+	if !v.topLevels[message] {
+		return false
+	}
+	if depth := 1 + nestingDepth(message.MessageBody); v.maxDepth < depth {
+		v.AddFailuref(
+			message.Meta.Pos,
+			"Message %q has the nesting depth of %d, but it must be at most %d",
+			message.MessageName,
+			depth,
+			v.maxDepth,
+		)
+	}
+	return false
+}
+
+// nestingDepth returns the depth of the messages and the groups nested in the message body.
+func nestingDepth(body []parser.Visitee) int {
+	var max int
+	for _, element := range body {
+		var depth int
+		switch e := element.(type) {
+		case *parser.Message:
+			depth = 1 + nestingDepth(e.MessageBody)
+		case *parser.GroupField:
+			depth = 1 + nestingDepth(e.MessageBody)
+		}
+		if max < depth {
+			max = depth
+		}
+	}
+	return max
+}
@@ -0,0 +1,102 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxMessageNestingDepthRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newMessage := func(name string, body ...parser.Visitee) *parser.Message {
+		return &parser.Message{
+			MessageName: name,
+			MessageBody: body,
+			Meta:        meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		inputMaxDepth int
+		inputProto    *parser.Proto
+		wantFailures  []report.Failure
+	}{
+		{
+			name: "no failures for proto with the messages within the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newMessage("Library",
+						newMessage("Shelf",
+							newMessage("Book"),
+						),
+					),
+					newMessage("Book"),
+				},
+			},
+		},
+		{
+			name: "failures for proto with the messages over the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newMessage("Library",
+						&parser.Field{},
+						newMessage("Shelf"),
+						newMessage("Room",
+							newMessage("Shelf",
+								newMessage("Book"),
+							),
+						),
+					),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_MESSAGE_NESTING_DEPTH", `Message "Library" has the nesting depth of 4, but it must be at most 3`),
+			},
+		},
+		{
+			name:          "failures for proto with the messages and the groups over the maximum",
+			inputMaxDepth: 1,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newMessage("Library",
+						&parser.GroupField{
+							GroupName: "Shelf",
+						},
+					),
+					newMessage("Book"),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_MESSAGE_NESTING_DEPTH", `Message "Library" has the nesting depth of 2, but it must be at most 1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxMessageNestingDepthRule(test.inputMaxDepth)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
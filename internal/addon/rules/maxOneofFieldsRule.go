@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultMaxOneofFields = 20
+
+// MaxOneofFieldsRule enforces a maximum number of fields in a oneof.
+type MaxOneofFieldsRule struct {
+	maxFields int
+}
+
+// NewMaxOneofFieldsRule creates a new MaxOneofFieldsRule.
+func NewMaxOneofFieldsRule(
+	maxFields int,
+) MaxOneofFieldsRule {
+	if maxFields == 0 {
+		maxFields = defaultMaxOneofFields
+	}
+	return MaxOneofFieldsRule{
+		maxFields: maxFields,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxOneofFieldsRule) ID() string {
+	return "MAX_ONEOF_FIELDS"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxOneofFieldsRule) Purpose() string {
+	return "Enforces a maximum number of fields in a oneof."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxOneofFieldsRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxOneofFieldsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A oneof with too many fields generates the switch statements every client has to keep up with. Group the fields into the messages.",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_fields",
+				Description: "The maximum number of fields in a oneof. The default is 20.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxOneofFieldsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &maxOneofFieldsVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		maxFields:      r.maxFields,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type maxOneofFieldsVisitor struct {
+	*visitor.BaseAddVisitor
+	maxFields int
+}
+
+// VisitOneof checks the oneof.
+func (v *maxOneofFieldsVisitor) VisitOneof(oneof *parser.Oneof) bool {
+	if count := len(oneof.OneofFields); v.maxFields < count {
+		v.AddFailuref(
+			oneof.Meta.Pos,
+			"Oneof %q has %d fields, but it must have at most %d",
+			oneof.OneofName,
+			count,
+			v.maxFields,
+		)
+	}
+	return false
+}
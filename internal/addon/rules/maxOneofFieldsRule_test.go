@@ -0,0 +1,105 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxOneofFieldsRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newOneof := func(name string, fieldCount int) *parser.Oneof {
+		var fields []*parser.OneofField
+		for i := 0; i < fieldCount; i++ {
+			fields = append(fields, &parser.OneofField{})
+		}
+		return &parser.Oneof{
+			OneofName:   name,
+			OneofFields: fields,
+			Meta:        meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		inputMaxFields int
+		inputProto     *parser.Proto
+		wantFailures   []report.Failure
+	}{
+		{
+			name: "no failures for proto with the oneofs within the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							newOneof("source", 20),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the oneofs over the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							newOneof("source", 21),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_ONEOF_FIELDS", `Oneof "source" has 21 fields, but it must have at most 20`),
+			},
+		},
+		{
+			name:           "failures for proto with the oneofs over the maximum",
+			inputMaxFields: 2,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							newOneof("source", 2),
+							&parser.Message{
+								MessageBody: []parser.Visitee{
+									newOneof("format", 3),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_ONEOF_FIELDS", `Oneof "format" has 3 fields, but it must have at most 2`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxOneofFieldsRule(test.inputMaxFields)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultMaxServiceRPCs = 30
+
+// MaxServiceRPCsRule enforces a maximum number of RPCs in a service.
+type MaxServiceRPCsRule struct {
+	maxRPCs int
+}
+
+// NewMaxServiceRPCsRule creates a new MaxServiceRPCsRule.
+func NewMaxServiceRPCsRule(
+	maxRPCs int,
+) MaxServiceRPCsRule {
+	if maxRPCs == 0 {
+		maxRPCs = defaultMaxServiceRPCs
+	}
+	return MaxServiceRPCsRule{
+		maxRPCs: maxRPCs,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r MaxServiceRPCsRule) ID() string {
+	return "MAX_SERVICE_RPCS"
+}
+
+// Purpose returns the purpose of this rule.
+func (r MaxServiceRPCsRule) Purpose() string {
+	return "Enforces a maximum number of RPCs in a service."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r MaxServiceRPCsRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r MaxServiceRPCsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A service with too many RPCs mixes the responsibilities and generates the clients and the servers too large to read. Split it by the resources.",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "max_rpcs",
+				Description: "The maximum number of RPCs in a service. The default is 30.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r MaxServiceRPCsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &maxServiceRPCsVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		maxRPCs:        r.maxRPCs,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type maxServiceRPCsVisitor struct {
+	*visitor.BaseAddVisitor
+	maxRPCs int
+}
+
+// VisitService checks the service.
+func (v *maxServiceRPCsVisitor) VisitService(service *parser.Service) bool {
+	if count := countServiceRPCs(service.ServiceBody); v.maxRPCs < count {
+		v.AddFailuref(
+			service.Meta.Pos,
+			"Service %q has %d RPCs, but it must have at most %d",
+			service.ServiceName,
+			count,
+			v.maxRPCs,
+		)
+	}
+	return false
+}
+
+// countServiceRPCs returns the number of RPCs in the service body.
+func countServiceRPCs(body []parser.Visitee) int {
+	var count int
+	for _, element := range body {
+		if _, ok := element.(*parser.RPC); ok {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,91 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestMaxServiceRPCsRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newService := func(name string, rpcCount int) *parser.Service {
+		body := []parser.Visitee{
+			&parser.Option{},
+		}
+		for i := 0; i < rpcCount; i++ {
+			body = append(body, &parser.RPC{})
+		}
+		return &parser.Service{
+			ServiceName: name,
+			ServiceBody: body,
+			Meta:        meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		inputMaxRPCs int
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the services within the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newService("LibraryService", 30),
+				},
+			},
+		},
+		{
+			name: "failures for proto with the services over the default maximum",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newService("LibraryService", 31),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_SERVICE_RPCS", `Service "LibraryService" has 31 RPCs, but it must have at most 30`),
+			},
+		},
+		{
+			name:         "failures for proto with the services over the maximum",
+			inputMaxRPCs: 1,
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					newService("BookService", 1),
+					newService("LibraryService", 2),
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "MAX_SERVICE_RPCS", `Service "LibraryService" has 2 RPCs, but it must have at most 1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewMaxServiceRPCsRule(test.inputMaxRPCs)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+)
+
+// messageIndex looks up the messages by the fully-qualified names, e.g. acme.library.v1.Book.Page.
+type messageIndex map[string]*parser.Message
+
+// newMessageIndex creates a messageIndex of the proto and the files of the linted set.
+// The messages of the proto take precedence over those of the set with the same names.
+func newMessageIndex(proto *parser.Proto, protoSet *file.ParsedProtoSet) messageIndex {
+	index := make(messageIndex)
+	index.add(protoPackageName(proto), proto.ProtoBody)
+	for _, other := range protoSet.Protos() {
+		index.add(protoPackageName(other), other.ProtoBody)
+	}
+	return index
+}
+
+func (index messageIndex) add(scope string, body []parser.Visitee) {
+	for _, element := range body {
+		message, ok := element.(*parser.Message)
+		if !ok {
+			continue
+		}
+		name := qualifiedName(scope, message.MessageName)
+		if _, ok := index[name]; !ok {
+			index[name] = message
+		}
+		index.add(name, message.MessageBody)
+	}
+}
+
+// resolve looks up the message type referenced in the scope like the package or the enclosing message.
+// It follows the scoping rules of protobuf, searching from the innermost scope to the outermost.
+// It returns nil if the message is not in the index, e.g. it is in an imported file out of the linted set.
+func (index messageIndex) resolve(messageType string, scope string) (*parser.Message, string) {
+	name := resolveTypeName(messageType, scope, func(name string) bool {
+		_, ok := index[name]
+		return ok
+	})
+	return index[name], name
+}
+
+// resolveTypeName returns the fully-qualified name of the type referenced in the scope, for which exists returns true.
+// It returns empty if no such name exists.
+func resolveTypeName(typeName string, scope string, exists func(name string) bool) string {
+	if strings.HasPrefix(typeName, ".") {
+		name := fullMessageType(typeName)
+		if !exists(name) {
+			return ""
+		}
+		return name
+	}
+	for {
+		name := qualifiedName(scope, typeName)
+		if exists(name) {
+			return name
+		}
+		if scope == "" {
+			return ""
+		}
+		scope = parentScope(scope)
+	}
+}
+
+// fieldByPath looks up the field by the path like book.name, descending into the message fields.
+// It returns the type of the last field, or false if any of the fields on the path is missing.
+func (index messageIndex) fieldByPath(message *parser.Message, scope string, fieldPath string) (string, bool) {
+	segments := strings.Split(fieldPath, ".")
+	for i, segment := range segments {
+		typ, ok := messageFieldTypes(message)[segment]
+		if !ok {
+			return "", false
+		}
+		if i == len(segments)-1 {
+			return typ, true
+		}
+		message, scope = index.resolve(typ, scope)
+		if message == nil {
+			return "", false
+		}
+	}
+	return "", false
+}
+
+// messageFieldTypes returns the types of the fields in the message keyed by the field names.
+// The types of the map fields are "map" which has no fields to descend.
+func messageFieldTypes(message *parser.Message) map[string]string {
+	types := make(map[string]string)
+	for _, element := range message.MessageBody {
+		switch e := element.(type) {
+		case *parser.Field:
+			types[e.FieldName] = e.Type
+		case *parser.MapField:
+			types[e.MapName] = "map"
+		case *parser.Oneof:
+			for _, f := range e.OneofFields {
+				types[f.FieldName] = f.Type
+			}
+		}
+	}
+	return types
+}
+
+// forEachRPC calls fn with the RPCs in the proto and the files of the linted set, and the packages of their files.
+func forEachRPC(proto *parser.Proto, protoSet *file.ParsedProtoSet, fn func(rpc *parser.RPC, pkg string)) {
+	for _, p := range append([]*parser.Proto{proto}, protoSet.Protos()...) {
+		pkg := protoPackageName(p)
+		for _, element := range p.ProtoBody {
+			service, ok := element.(*parser.Service)
+			if !ok {
+				continue
+			}
+			for _, element := range service.ServiceBody {
+				if rpc, ok := element.(*parser.RPC); ok {
+					fn(rpc, pkg)
+				}
+			}
+		}
+	}
+}
+
+// qualifiedName returns the name in the scope.
+func qualifiedName(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// parentScope returns the scope enclosing the scope. It returns empty for the outermost scope.
+func parentScope(scope string) string {
+	if i := strings.LastIndex(scope, "."); 0 <= i {
+		return scope[:i]
+	}
+	return ""
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tyhal/protolint/internal/stringsutil"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -45,6 +46,25 @@ func (r MessageNamesExcludePrepositionsRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r MessageNamesExcludePrepositionsRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:   "Prepositions in message names often indicate that the message mixes two concepts, for example BookWithAuthor.",
+		BadExample:  "message BookWithAuthor {}",
+		GoodExample: "message AuthoredBook {}",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "prepositions",
+				Description: "The prepositions to use instead of the built-in list.",
+			},
+			{
+				Name:        "excludes",
+				Description: "The words containing prepositions to allow, e.g. SpecialEndOfSupport.",
+			},
+		},
+	}
+}
+
 // Purpose returns the purpose of this rule.
 func (r MessageNamesExcludePrepositionsRule) Purpose() string {
 	return `Verifies that all message names don't include prepositions (e.g. "With", "For").`
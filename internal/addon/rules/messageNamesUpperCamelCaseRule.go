@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -32,6 +33,16 @@ func (r MessageNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r MessageNamesUpperCamelCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The style guide uses CamelCase with an initial capital for message names.",
+		BadExample:    "message song_server_request {}",
+		GoodExample:   "message SongServerRequest {}",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#message-and-field-names",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MessageNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &messageNamesUpperCamelCaseVisitor{
@@ -7,6 +7,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -41,6 +42,22 @@ func (r MessagesHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r MessagesHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:  "A comment on each message documents what the message represents for the API consumers.",
+		BadExample: "message Book {}",
+		GoodExample: `// Book represents a published book.
+message Book {}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the message. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MessagesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &messagesHaveCommentVisitor{
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/strs"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// OneofNamesLowerSnakeCaseRule verifies that all oneof names are underscore_separated_names.
+type OneofNamesLowerSnakeCaseRule struct{}
+
+// NewOneofNamesLowerSnakeCaseRule creates a new OneofNamesLowerSnakeCaseRule.
+func NewOneofNamesLowerSnakeCaseRule() OneofNamesLowerSnakeCaseRule {
+	return OneofNamesLowerSnakeCaseRule{}
+}
+
+// ID returns the ID of this rule.
+func (r OneofNamesLowerSnakeCaseRule) ID() string {
+	return "ONEOF_NAMES_LOWER_SNAKE_CASE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r OneofNamesLowerSnakeCaseRule) Purpose() string {
+	return "Verifies that all oneof names are underscore_separated_names."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r OneofNamesLowerSnakeCaseRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r OneofNamesLowerSnakeCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The code generators derive the accessors and the case enums from the oneof names as they do from the field names. Naming them like the fields keeps the generated code consistent.",
+		BadExample: `message Book {
+  oneof bookSource {
+    string url = 1;
+  }
+}`,
+		GoodExample: `message Book {
+  oneof book_source {
+    string url = 1;
+  }
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r OneofNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &oneofNamesLowerSnakeCaseVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type oneofNamesLowerSnakeCaseVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitOneof checks the oneof.
+func (v *oneofNamesLowerSnakeCaseVisitor) VisitOneof(oneof *parser.Oneof) bool {
+	if !strs.IsLowerSnakeCase(oneof.OneofName) {
+		v.AddFailuref(oneof.Meta.Pos, "Oneof name %q must be underscore_separated_names", oneof.OneofName)
+	}
+	return false
+}
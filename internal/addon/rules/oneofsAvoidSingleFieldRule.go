@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// OneofsAvoidSingleFieldRule verifies that all oneofs have more than one field.
+type OneofsAvoidSingleFieldRule struct{}
+
+// NewOneofsAvoidSingleFieldRule creates a new OneofsAvoidSingleFieldRule.
+func NewOneofsAvoidSingleFieldRule() OneofsAvoidSingleFieldRule {
+	return OneofsAvoidSingleFieldRule{}
+}
+
+// ID returns the ID of this rule.
+func (r OneofsAvoidSingleFieldRule) ID() string {
+	return "ONEOFS_AVOID_SINGLE_FIELD"
+}
+
+// Purpose returns the purpose of this rule.
+func (r OneofsAvoidSingleFieldRule) Purpose() string {
+	return "Verifies that all oneofs have more than one field."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r OneofsAvoidSingleFieldRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r OneofsAvoidSingleFieldRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A oneof with a single field only tracks the presence of the field, which an optional field does with simpler generated code. Moving a field into an existing oneof later is not wire-safe for all the languages either.",
+		BadExample: `message Book {
+  oneof source {
+    string url = 1;
+  }
+}`,
+		GoodExample: `message Book {
+  optional string url = 1;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r OneofsAvoidSingleFieldRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &oneofsAvoidSingleFieldVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type oneofsAvoidSingleFieldVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitOneof checks the oneof.
+func (v *oneofsAvoidSingleFieldVisitor) VisitOneof(oneof *parser.Oneof) bool {
+	if len(oneof.OneofFields) == 1 {
+		v.AddFailuref(
+			oneof.Meta.Pos,
+			"Oneof %q has only the field %q and should be replaced with an optional field",
+			oneof.OneofName, oneof.OneofFields[0].FieldName,
+		)
+	}
+	return false
+}
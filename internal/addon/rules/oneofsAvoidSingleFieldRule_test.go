@@ -0,0 +1,114 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestOneofsAvoidSingleFieldRule_Apply(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto without oneofs",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with the oneofs of multiple fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofName: "source",
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName: "url",
+									},
+									{
+										FieldName: "content",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the oneofs of a single field",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Message{
+								MessageBody: []parser.Visitee{
+									&parser.Oneof{
+										OneofName: "source",
+										OneofFields: []*parser.OneofField{
+											{
+												FieldName: "url",
+											},
+										},
+										Meta: meta.Meta{
+											Pos: meta.Position{
+												Filename: "example.proto",
+												Offset:   100,
+												Line:     5,
+												Column:   10,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ONEOFS_AVOID_SINGLE_FIELD",
+					`Oneof "source" has only the field "url" and should be replaced with an optional field`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewOneofsAvoidSingleFieldRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
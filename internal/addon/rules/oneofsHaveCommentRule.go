@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// OneofsHaveCommentRule verifies that all oneofs have a comment.
+type OneofsHaveCommentRule struct {
+	// Golang style comments should begin with the name of the thing being described.
+	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
+	shouldFollowGolangStyle bool
+}
+
+// NewOneofsHaveCommentRule creates a new OneofsHaveCommentRule.
+func NewOneofsHaveCommentRule(
+	shouldFollowGolangStyle bool,
+) OneofsHaveCommentRule {
+	return OneofsHaveCommentRule{
+		shouldFollowGolangStyle: shouldFollowGolangStyle,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r OneofsHaveCommentRule) ID() string {
+	return "ONEOFS_HAVE_COMMENT"
+}
+
+// Purpose returns the purpose of this rule.
+func (r OneofsHaveCommentRule) Purpose() string {
+	return "Verifies that all oneofs have a comment."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r OneofsHaveCommentRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r OneofsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A comment on each oneof documents what the alternatives have in common and what it means when none of them is set.",
+		BadExample: `message Book {
+  oneof source {
+    string url = 1;
+    bytes content = 2;
+  }
+}`,
+		GoodExample: `message Book {
+  // source is where the content of the book comes from.
+  oneof source {
+    string url = 1;
+    bytes content = 2;
+  }
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the oneof. The default is false.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r OneofsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &oneofsHaveCommentVisitor{
+		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID()),
+		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type oneofsHaveCommentVisitor struct {
+	*visitor.BaseAddVisitor
+	shouldFollowGolangStyle bool
+}
+
+// VisitOneof checks the oneof.
+func (v *oneofsHaveCommentVisitor) VisitOneof(oneof *parser.Oneof) bool {
+	n := oneof.OneofName
+	if v.shouldFollowGolangStyle && !hasGolangStyleComment(oneof.Comments, n) {
+		v.AddFailuref(oneof.Meta.Pos, `Oneof %q should have a comment of the form "// %s ..."`, n, n)
+	} else if !hasComment(oneof.Comments) {
+		v.AddFailuref(oneof.Meta.Pos, `Oneof %q should have a comment`, n)
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestOneofsHaveCommentRule_Apply(t *testing.T) {
+	tests := []struct {
+		name                         string
+		inputProto                   *parser.Proto
+		inputShouldFollowGolangStyle bool
+		wantFailures                 []report.Failure
+	}{
+		{
+			name: "no failures for proto without oneof",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{},
+			},
+		},
+		{
+			name: "no failures for proto including valid oneofs with comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofName: "source",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// a oneof name.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto including valid oneofs with Golang style comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofName: "source",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// source is a oneof name.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			inputShouldFollowGolangStyle: true,
+		},
+		{
+			name: "failures for proto with invalid oneofs",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofName: "source",
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName: "url",
+										Comments: []*parser.Comment{
+											{
+												Raw: "// url is a oneof field name.",
+											},
+										},
+									},
+								},
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   150,
+										Line:     7,
+										Column:   15,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   150,
+						Line:     7,
+						Column:   15,
+					},
+					"ONEOFS_HAVE_COMMENT",
+					`Oneof "source" should have a comment`,
+				),
+			},
+		},
+		{
+			name: "failures for proto with invalid oneofs without Golang style comments",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Oneof{
+								OneofName: "source",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// a oneof name.",
+									},
+								},
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   150,
+										Line:     7,
+										Column:   15,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			inputShouldFollowGolangStyle: true,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   150,
+						Line:     7,
+						Column:   15,
+					},
+					"ONEOFS_HAVE_COMMENT",
+					`Oneof "source" should have a comment of the form "// source ..."`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewOneofsHaveCommentRule(test.inputShouldFollowGolangStyle)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -36,6 +37,20 @@ func (r OrderRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r OrderRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A fixed order of the syntax, package, imports, options and the other definitions makes every file look alike.",
+		BadExample: `syntax = "proto3";
+import "a.proto";
+package foo;`,
+		GoodExample: `syntax = "proto3";
+package foo;
+import "a.proto";`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#file-structure",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r OrderRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &orderVisitor{
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// PackageDefinedRule verifies that all files define a package.
+type PackageDefinedRule struct{}
+
+// NewPackageDefinedRule creates a new PackageDefinedRule.
+func NewPackageDefinedRule() PackageDefinedRule {
+	return PackageDefinedRule{}
+}
+
+// ID returns the ID of this rule.
+func (r PackageDefinedRule) ID() string {
+	return "PACKAGE_DEFINED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r PackageDefinedRule) Purpose() string {
+	return "Verifies that all files define a package."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r PackageDefinedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r PackageDefinedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The definitions of a file without a package share the global scope, where they can conflict with the ones of any other file.",
+		BadExample: `syntax = "proto3";
+
+message Book {}`,
+		GoodExample: `syntax = "proto3";
+
+package acme.library.v1;
+
+message Book {}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#packages",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r PackageDefinedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &packageDefinedVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		hasPackage:     protoPackageName(proto) != "",
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type packageDefinedVisitor struct {
+	*visitor.BaseAddVisitor
+	hasPackage bool
+}
+
+// VisitSyntax checks the file has a package.
+func (v *packageDefinedVisitor) VisitSyntax(s *parser.Syntax) bool {
+	if !v.hasPackage {
+		v.AddFailuref(s.Meta.Pos, "File should define a package")
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestPackageDefinedRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   0,
+		Line:     1,
+		Column:   1,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with a package",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+					Meta:            meta.Meta{Pos: pos},
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing.v1",
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto without a package",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto3",
+					Meta:            meta.Meta{Pos: pos},
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageName: "Invoice",
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PACKAGE_DEFINED", `File should define a package`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewPackageDefinedRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// PackageDirectoryMatchRule verifies that the file is in the directory matching the package (e.g. "acme/billing/v1" for "acme.billing.v1").
+// The directory is matched with the absolute path, so that the result doesn't depend on the working directory.
+type PackageDirectoryMatchRule struct{}
+
+// NewPackageDirectoryMatchRule creates a new PackageDirectoryMatchRule.
+func NewPackageDirectoryMatchRule() PackageDirectoryMatchRule {
+	return PackageDirectoryMatchRule{}
+}
+
+// ID returns the ID of this rule.
+func (r PackageDirectoryMatchRule) ID() string {
+	return "PACKAGE_DIRECTORY_MATCH"
+}
+
+// Purpose returns the purpose of this rule.
+func (r PackageDirectoryMatchRule) Purpose() string {
+	return `Verifies that the file is in the directory matching the package (e.g. "acme/billing/v1" for "acme.billing.v1").`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r PackageDirectoryMatchRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r PackageDirectoryMatchRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The import paths and the generated code of most languages follow the directories. Matching them with the packages makes the files easy to find from the names.",
+		BadExample: `// acme/billing/invoice.proto
+package acme.billing.v1;`,
+		GoodExample: `// acme/billing/v1/invoice.proto
+package acme.billing.v1;`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r PackageDirectoryMatchRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	dir := filepath.Dir(proto.Meta.Filename)
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	v := &packageDirectoryMatchVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		dir:            filepath.ToSlash(dir),
+		absDir:         filepath.ToSlash(absDir),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type packageDirectoryMatchVisitor struct {
+	*visitor.BaseAddVisitor
+	// dir is the directory to display, and absDir is the one to match.
+	dir    string
+	absDir string
+}
+
+// VisitPackage checks the package.
+func (v *packageDirectoryMatchVisitor) VisitPackage(p *parser.Package) bool {
+	want := strings.Replace(p.Name, ".", "/", -1)
+	if !strings.HasSuffix(v.absDir, "/"+want) {
+		v.AddFailuref(p.Meta.Pos, "Package %q should be in the directory %q, but it is in %q", p.Name, want, v.dir)
+	}
+	return false
+}
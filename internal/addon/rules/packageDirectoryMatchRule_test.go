@@ -0,0 +1,117 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestPackageDirectoryMatchRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   20,
+		Line:     3,
+		Column:   1,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for the file in the directory matching the package",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing.v1",
+					},
+				},
+				Meta: &parser.ProtoMeta{
+					Filename: "proto/acme/billing/v1/invoice.proto",
+				},
+			},
+		},
+		{
+			name: "no failures for the file in the relative directory matching the package",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing.v1",
+					},
+				},
+				Meta: &parser.ProtoMeta{
+					Filename: "acme/billing/v1/invoice.proto",
+				},
+			},
+		},
+		{
+			name: "no failures for the file in the working directory matching the package",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "addon.rules",
+					},
+				},
+				Meta: &parser.ProtoMeta{
+					Filename: "invoice.proto",
+				},
+			},
+		},
+		{
+			name: "failures for the file in the directory not matching the package",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing.v1",
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+				Meta: &parser.ProtoMeta{
+					Filename: "proto/xacme/billing/invoice.proto",
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PACKAGE_DIRECTORY_MATCH", `Package "acme.billing.v1" should be in the directory "acme/billing/v1", but it is in "proto/xacme/billing"`),
+			},
+		},
+		{
+			name: "failures for the file in the current directory",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "billing",
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+				Meta: &parser.ProtoMeta{
+					Filename: "invoice.proto",
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PACKAGE_DIRECTORY_MATCH", `Package "billing" should be in the directory "billing", but it is in "."`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewPackageDirectoryMatchRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
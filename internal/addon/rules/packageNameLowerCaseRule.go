@@ -6,6 +6,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -34,6 +35,16 @@ func (r PackageNameLowerCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r PackageNameLowerCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The style guide uses lowercase package names, so that they map to the package names of the generated code without conflicts.",
+		BadExample:    "package myPackage;",
+		GoodExample:   "package my.package;",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#packages",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r PackageNameLowerCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &packageNameLowerCaseVisitor{
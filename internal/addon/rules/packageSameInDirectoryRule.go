@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"path/filepath"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// PackageSameInDirectoryRule verifies that all files in a directory share one package.
+// The first file in the linted set defining a package in the directory is the reference of the others.
+type PackageSameInDirectoryRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewPackageSameInDirectoryRule creates a new PackageSameInDirectoryRule.
+// The files in the same directory are looked up from protoSet.
+func NewPackageSameInDirectoryRule(
+	protoSet *file.ParsedProtoSet,
+) PackageSameInDirectoryRule {
+	return PackageSameInDirectoryRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r PackageSameInDirectoryRule) ID() string {
+	return "PACKAGE_SAME_IN_DIRECTORY"
+}
+
+// Purpose returns the purpose of this rule.
+func (r PackageSameInDirectoryRule) Purpose() string {
+	return "Verifies that all files in a directory share one package."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r PackageSameInDirectoryRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r PackageSameInDirectoryRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Languages like Go generate a package per directory. The files with different packages in a directory generate conflicting code.",
+		BadExample: `// acme/billing/v1/invoice.proto
+package acme.billing.v1;
+
+// acme/billing/v1/payment.proto
+package acme.payment.v1;`,
+		GoodExample: `// acme/billing/v1/invoice.proto
+package acme.billing.v1;
+
+// acme/payment/v1/payment.proto
+package acme.payment.v1;`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r PackageSameInDirectoryRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &packageSameInDirectoryVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		filename:       proto.Meta.Filename,
+	}
+
+	dir := filepath.Dir(proto.Meta.Filename)
+	for _, other := range r.protoSet.Protos() {
+		if filepath.Dir(other.Meta.Filename) != dir {
+			continue
+		}
+		if pkg := protoPackageName(other); pkg != "" {
+			v.reference = pkg
+			v.referenceFilename = other.Meta.Filename
+			break
+		}
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type packageSameInDirectoryVisitor struct {
+	*visitor.BaseAddVisitor
+	filename          string
+	reference         string
+	referenceFilename string
+}
+
+// VisitPackage checks the package.
+func (v *packageSameInDirectoryVisitor) VisitPackage(p *parser.Package) bool {
+	if v.reference == "" || v.referenceFilename == v.filename || v.reference == p.Name {
+		return false
+	}
+	v.AddFailuref(p.Meta.Pos, "Package %q differs from %q in %q in the same directory", p.Name, v.reference, v.referenceFilename)
+	return false
+}
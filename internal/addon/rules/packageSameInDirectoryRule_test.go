@@ -0,0 +1,88 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestPackageSameInDirectoryRule_Apply(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "empty.proto"),
+		setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "invoice.proto"),
+		setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "refund.proto"),
+		setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "payment.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFile     file.ProtoFile
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:          "no failures for the first file with a package in the directory",
+			inputFile:     setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "invoice.proto"),
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "no failures for the file with the same package",
+			inputFile:     setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "refund.proto"),
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "no failures for the file in the other directory",
+			inputFile:     setting_test.TestProtoFile("acme/payment/v1", "rules", "packageSameInDirectory", "payment.proto"),
+			inputProtoSet: protoSet,
+		},
+		{
+			name:      "no failures without the linted set",
+			inputFile: setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "payment.proto"),
+		},
+		{
+			name:          "failures for the file with the different package",
+			inputFile:     setting_test.TestProtoFile("acme/billing/v1", "rules", "packageSameInDirectory", "payment.proto"),
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/billing/v1/payment.proto",
+						Offset:   20,
+						Line:     3,
+						Column:   1,
+					},
+					"PACKAGE_SAME_IN_DIRECTORY",
+					`Package "acme.payment.v1" differs from "acme.billing.v1" in "acme/billing/v1/invoice.proto" in the same directory`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewPackageSameInDirectoryRule(test.inputProtoSet)
+
+			proto, err := test.inputFile.Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
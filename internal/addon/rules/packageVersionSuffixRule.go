@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// PackageVersionSuffixRule verifies that the last component of the package is a version (e.g. "v1", "v1beta1").
+type PackageVersionSuffixRule struct{}
+
+// NewPackageVersionSuffixRule creates a new PackageVersionSuffixRule.
+func NewPackageVersionSuffixRule() PackageVersionSuffixRule {
+	return PackageVersionSuffixRule{}
+}
+
+// ID returns the ID of this rule.
+func (r PackageVersionSuffixRule) ID() string {
+	return "PACKAGE_VERSION_SUFFIX"
+}
+
+// Purpose returns the purpose of this rule.
+func (r PackageVersionSuffixRule) Purpose() string {
+	return `Verifies that the last component of the package is a version (e.g. "v1", "v1beta1").`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r PackageVersionSuffixRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r PackageVersionSuffixRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "A versioned package lets a breaking change ship as a new package next to the old one, so that the clients can migrate at their own pace.",
+		BadExample:    "package acme.billing;",
+		GoodExample:   "package acme.billing.v1;",
+		StyleGuideURL: "https://cloud.google.com/apis/design/versioning",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r PackageVersionSuffixRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &packageVersionSuffixVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type packageVersionSuffixVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitPackage checks the package.
+func (v *packageVersionSuffixVisitor) VisitPackage(p *parser.Package) bool {
+	components := strings.Split(p.Name, ".")
+	if last := components[len(components)-1]; len(components) < 2 || !versionSegment.MatchString(last) {
+		v.AddFailuref(p.Meta.Pos, "Package name %q should end with a version like v1 or v1beta1", p.Name)
+	}
+	return false
+}
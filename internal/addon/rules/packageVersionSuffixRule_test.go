@@ -0,0 +1,84 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestPackageVersionSuffixRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   20,
+		Line:     3,
+		Column:   1,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the versioned packages",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing.v1",
+					},
+					&parser.Package{
+						Name: "acme.billing.v2beta1",
+					},
+					&parser.Package{
+						Name: "acme.billing.v1alpha",
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the packages without version",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.billing",
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Package{
+						Name: "v1",
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Package{
+						Name: "acme.billing.version1",
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PACKAGE_VERSION_SUFFIX", `Package name "acme.billing" should end with a version like v1 or v1beta1`),
+				report.Failuref(pos, "PACKAGE_VERSION_SUFFIX", `Package name "v1" should end with a version like v1 or v1beta1`),
+				report.Failuref(pos, "PACKAGE_VERSION_SUFFIX", `Package name "acme.billing.version1" should end with a version like v1 or v1beta1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewPackageVersionSuffixRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
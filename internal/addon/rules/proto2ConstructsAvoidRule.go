@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const (
+	proto2ConstructRequired   = "required"
+	proto2ConstructGroup      = "group"
+	proto2ConstructExtensions = "extensions"
+	proto2ConstructDefault    = "default"
+)
+
+// proto2Constructs are the constructs which proto3 doesn't support.
+var proto2Constructs = []string{
+	proto2ConstructRequired,
+	proto2ConstructGroup,
+	proto2ConstructExtensions,
+	proto2ConstructDefault,
+}
+
+// Proto2ConstructsAvoidRule verifies that the proto2-only constructs are avoided regardless of the syntax.
+// It helps to migrate the proto2 files to proto3.
+type Proto2ConstructsAvoidRule struct {
+	constructs []string
+}
+
+// NewProto2ConstructsAvoidRule creates a new Proto2ConstructsAvoidRule.
+// All the constructs are avoided if constructs is empty.
+// The unknown constructs are reported by the validation of the config, and ignored here.
+func NewProto2ConstructsAvoidRule(
+	constructs []string,
+) Proto2ConstructsAvoidRule {
+	if len(constructs) == 0 {
+		constructs = proto2Constructs
+	}
+	return Proto2ConstructsAvoidRule{
+		constructs: constructs,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r Proto2ConstructsAvoidRule) ID() string {
+	return "PROTO2_CONSTRUCTS_AVOID"
+}
+
+// Purpose returns the purpose of this rule.
+func (r Proto2ConstructsAvoidRule) Purpose() string {
+	return "Verifies that the proto2-only constructs like required, groups, extensions and default values are avoided."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r Proto2ConstructsAvoidRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r Proto2ConstructsAvoidRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The proto2 files using none of these constructs can switch to proto3 by changing only the syntax statement. Flagging them in the proto2 files shows what is left for the migration.",
+		BadExample: `syntax = "proto2";
+
+message Book {
+  required string title = 1;
+  optional int32 pages = 2 [default = 100];
+  extensions 100 to 199;
+}`,
+		GoodExample: `syntax = "proto2";
+
+message Book {
+  optional string title = 1;
+  optional int32 pages = 2;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "constructs",
+				Description: "The constructs to avoid, some of " + strings.Join(proto2Constructs, ", ") + ". The default is all of them.",
+				Values:      proto2Constructs,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r Proto2ConstructsAvoidRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &proto2ConstructsAvoidVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		constructs:     r.constructs,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type proto2ConstructsAvoidVisitor struct {
+	*visitor.BaseAddVisitor
+	constructs []string
+}
+
+func (v *proto2ConstructsAvoidVisitor) avoids(construct string) bool {
+	return stringsutil.ContainsStringInSlice(construct, v.constructs)
+}
+
+// VisitField checks the field.
+func (v *proto2ConstructsAvoidVisitor) VisitField(field *parser.Field) bool {
+	if v.avoids(proto2ConstructRequired) && field.IsRequired {
+		v.AddFailuref(field.Meta.Pos, "Field %q should not be required", field.FieldName)
+	}
+	if v.avoids(proto2ConstructDefault) {
+		for _, option := range field.FieldOptions {
+			if option.OptionName == "default" {
+				v.AddFailuref(field.Meta.Pos, "Field %q should not have the default value %s", field.FieldName, option.Constant)
+			}
+		}
+	}
+	return false
+}
+
+// VisitGroupField checks the group field.
+func (v *proto2ConstructsAvoidVisitor) VisitGroupField(field *parser.GroupField) bool {
+	if v.avoids(proto2ConstructGroup) {
+		v.AddFailuref(field.Meta.Pos, "Group %q should be replaced with a nested message", field.GroupName)
+	}
+	return true
+}
+
+// VisitExtensions checks the extensions.
+func (v *proto2ConstructsAvoidVisitor) VisitExtensions(extensions *parser.Extensions) bool {
+	if v.avoids(proto2ConstructExtensions) {
+		v.AddFailuref(extensions.Meta.Pos, "Extensions should be replaced with the fields or google.protobuf.Any")
+	}
+	return false
+}
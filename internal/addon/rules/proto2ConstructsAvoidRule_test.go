@@ -0,0 +1,138 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestProto2ConstructsAvoidRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	inputProto := &parser.Proto{
+		Syntax: &parser.Syntax{
+			ProtobufVersion: "proto2",
+		},
+		ProtoBody: []parser.Visitee{
+			&parser.Message{
+				MessageBody: []parser.Visitee{
+					&parser.Field{
+						FieldName: "isbn",
+					},
+					&parser.Field{
+						IsRequired: true,
+						FieldName:  "title",
+						Meta:       meta.Meta{Pos: pos},
+					},
+					&parser.Field{
+						IsOptional: true,
+						FieldName:  "pages",
+						FieldOptions: []*parser.FieldOption{
+							{
+								OptionName: "deprecated",
+								Constant:   "true",
+							},
+							{
+								OptionName: "default",
+								Constant:   "100",
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.GroupField{
+						GroupName: "Chapter",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								IsRequired: true,
+								FieldName:  "name",
+								Meta:       meta.Meta{Pos: pos},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Extensions{
+						Meta: meta.Meta{Pos: pos},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		inputConstructs []string
+		inputProto      *parser.Proto
+		wantFailures    []report.Failure
+	}{
+		{
+			name: "no failures for proto without proto2 constructs",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "proto2",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								IsOptional: true,
+								FieldName:  "title",
+							},
+							&parser.Extend{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "failures for proto with all the proto2 constructs by default",
+			inputProto: inputProto,
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Field "title" should not be required`),
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Field "pages" should not have the default value 100`),
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Group "Chapter" should be replaced with a nested message`),
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Field "name" should not be required`),
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Extensions should be replaced with the fields or google.protobuf.Any`),
+			},
+		},
+		{
+			name:            "failures for proto with the configured proto2 constructs",
+			inputConstructs: []string{"group", "default"},
+			inputProto:      inputProto,
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Field "pages" should not have the default value 100`),
+				report.Failuref(pos, "PROTO2_CONSTRUCTS_AVOID", `Group "Chapter" should be replaced with a nested message`),
+			},
+		},
+		{
+			name:            "no failures for the unknown construct, which the config validation reports",
+			inputConstructs: []string{"optional"},
+			inputProto:      inputProto,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewProto2ConstructsAvoidRule(test.inputConstructs)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
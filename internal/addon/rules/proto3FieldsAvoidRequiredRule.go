@@ -3,10 +3,11 @@ package rules
 import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
-// Proto3FieldsAvoidRequiredRule verifies that all fields should avoid required for proto3.
+// Proto3FieldsAvoidRequiredRule verifies that all fields should avoid required for proto3 and the editions.
 // See https://developers.google.com/protocol-buffers/docs/style#things-to-avoid
 type Proto3FieldsAvoidRequiredRule struct {
 }
@@ -23,7 +24,7 @@ func (r Proto3FieldsAvoidRequiredRule) ID() string {
 
 // Purpose returns the purpose of this rule.
 func (r Proto3FieldsAvoidRequiredRule) Purpose() string {
-	return "Verifies that all fields should avoid required for proto3."
+	return "Verifies that all fields should avoid required for proto3 and the editions."
 }
 
 // IsOfficial decides whether or not this rule belongs to the official guide.
@@ -31,6 +32,20 @@ func (r Proto3FieldsAvoidRequiredRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r Proto3FieldsAvoidRequiredRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Required fields are not supported in proto3 and the editions, and make the schema impossible to evolve.",
+		BadExample: `message Book {
+  required string title = 1;
+}`,
+		GoodExample: `message Book {
+  string title = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#things-to-avoid",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r Proto3FieldsAvoidRequiredRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &proto3FieldsAvoidRequiredVisitor{
@@ -42,11 +57,15 @@ func (r Proto3FieldsAvoidRequiredRule) Apply(proto *parser.Proto) ([]report.Fail
 type proto3FieldsAvoidRequiredVisitor struct {
 	*visitor.BaseAddVisitor
 	isProto3 bool
+	edition  string
 }
 
 // VisitSyntax checks the syntax.
 func (v *proto3FieldsAvoidRequiredVisitor) VisitSyntax(s *parser.Syntax) bool {
 	v.isProto3 = s.ProtobufVersion == "proto3"
+	if isEdition(s.ProtobufVersion) {
+		v.edition = s.ProtobufVersion
+	}
 	return false
 }
 
@@ -55,5 +74,8 @@ func (v *proto3FieldsAvoidRequiredVisitor) VisitField(field *parser.Field) bool
 	if v.isProto3 && field.IsRequired {
 		v.AddFailuref(field.Meta.Pos, `Field %q should avoid required for proto3`, field.FieldName)
 	}
+	if v.edition != "" && field.IsRequired {
+		v.AddFailuref(field.Meta.Pos, `Field %q should avoid required for edition %q`, field.FieldName, v.edition)
+	}
 	return false
 }
@@ -143,6 +143,47 @@ func TestProto3FieldsAvoidRequiredRule_Apply(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "failures for proto with required field names for the editions",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "2023",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "title",
+							},
+							&parser.Field{
+								IsRequired: true,
+								FieldName:  "song_name",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"PROTO3_FIELDS_AVOID_REQUIRED",
+					`Field "song_name" should avoid required for edition "2023"`,
+				),
+			},
+		},
 	}
 
 	for _, test := range tests {
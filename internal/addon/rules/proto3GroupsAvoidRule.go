@@ -3,10 +3,11 @@ package rules
 import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
-// Proto3GroupsAvoidRule verifies that all groups should be avoided for proto3.
+// Proto3GroupsAvoidRule verifies that all groups should be avoided for proto3 and the editions.
 // See https://developers.google.com/protocol-buffers/docs/style#things-to-avoid
 type Proto3GroupsAvoidRule struct {
 }
@@ -23,7 +24,7 @@ func (r Proto3GroupsAvoidRule) ID() string {
 
 // Purpose returns the purpose of this rule.
 func (r Proto3GroupsAvoidRule) Purpose() string {
-	return "Verifies that all groups should be avoided for proto3."
+	return "Verifies that all groups should be avoided for proto3 and the editions."
 }
 
 // IsOfficial decides whether or not this rule belongs to the official guide.
@@ -31,6 +32,25 @@ func (r Proto3GroupsAvoidRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r Proto3GroupsAvoidRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Groups are deprecated and not supported in proto3 and the editions. Use a nested message instead.",
+		BadExample: `message Book {
+  repeated group Chapter = 1 {
+    string title = 2;
+  }
+}`,
+		GoodExample: `message Book {
+  message Chapter {
+    string title = 1;
+  }
+  repeated Chapter chapters = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#things-to-avoid",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r Proto3GroupsAvoidRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &proto3GroupsAvoidVisitor{
@@ -42,11 +62,15 @@ func (r Proto3GroupsAvoidRule) Apply(proto *parser.Proto) ([]report.Failure, err
 type proto3GroupsAvoidVisitor struct {
 	*visitor.BaseAddVisitor
 	isProto3 bool
+	edition  string
 }
 
 // VisitSyntax checks the syntax.
 func (v *proto3GroupsAvoidVisitor) VisitSyntax(s *parser.Syntax) bool {
 	v.isProto3 = s.ProtobufVersion == "proto3"
+	if isEdition(s.ProtobufVersion) {
+		v.edition = s.ProtobufVersion
+	}
 	return false
 }
 
@@ -55,5 +79,8 @@ func (v *proto3GroupsAvoidVisitor) VisitGroupField(field *parser.GroupField) boo
 	if v.isProto3 {
 		v.AddFailuref(field.Meta.Pos, `Group %q should be avoided for proto3`, field.GroupName)
 	}
+	if v.edition != "" {
+		v.AddFailuref(field.Meta.Pos, `Group %q should be avoided for edition %q`, field.GroupName, v.edition)
+	}
 	return false
 }
@@ -103,6 +103,43 @@ func TestProto3GroupsAvoidRule_Apply(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "failures for proto with groups for the editions",
+			inputProto: &parser.Proto{
+				Syntax: &parser.Syntax{
+					ProtobufVersion: "2023",
+				},
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.GroupField{
+								GroupName: "Chapter",
+								Meta: meta.Meta{
+									Pos: meta.Position{
+										Filename: "example.proto",
+										Offset:   100,
+										Line:     5,
+										Column:   10,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"PROTO3_GROUPS_AVOID",
+					`Group "Chapter" should be avoided for edition "2023"`,
+				),
+			},
+		},
 	}
 
 	for _, test := range tests {
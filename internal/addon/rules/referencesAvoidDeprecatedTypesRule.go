@@ -0,0 +1,170 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// ReferencesAvoidDeprecatedTypesRule verifies that the fields and the RPCs don't reference the deprecated messages and enums.
+// The references from the deprecated fields, messages, services and RPCs are allowed.
+// The types are looked up from the proto and the linted set. The types out of the set are not verified.
+type ReferencesAvoidDeprecatedTypesRule struct {
+	protoSet *file.ParsedProtoSet
+}
+
+// NewReferencesAvoidDeprecatedTypesRule creates a new ReferencesAvoidDeprecatedTypesRule.
+// The types are looked up from protoSet as well.
+func NewReferencesAvoidDeprecatedTypesRule(
+	protoSet *file.ParsedProtoSet,
+) ReferencesAvoidDeprecatedTypesRule {
+	return ReferencesAvoidDeprecatedTypesRule{
+		protoSet: protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r ReferencesAvoidDeprecatedTypesRule) ID() string {
+	return "REFERENCES_AVOID_DEPRECATED_TYPES"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ReferencesAvoidDeprecatedTypesRule) Purpose() string {
+	return "Verifies that the fields and the RPCs which aren't deprecated don't reference the deprecated messages and enums."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ReferencesAvoidDeprecatedTypesRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r ReferencesAvoidDeprecatedTypesRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A deprecated type can't be removed while the rest of the schema still uses it. The references should be deprecated together or moved to the replacement.",
+		BadExample: `message Author {
+  option deprecated = true;
+}
+
+message Book {
+  Author author = 1;
+}`,
+		GoodExample: `message Author {
+  option deprecated = true;
+}
+
+message Book {
+  Author author = 1 [deprecated = true];
+  Person writer = 2;
+}`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r ReferencesAvoidDeprecatedTypesRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &referencesAvoidDeprecatedTypesVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		types:          newTypeIndex(proto, r.protoSet),
+		references:     make(map[parser.Visitee][]deprecatedTypeReference),
+	}
+	v.collectReferences(protoPackageName(proto), proto.ProtoBody, false)
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+// deprecatedTypeReference is a reference to a deprecated type.
+type deprecatedTypeReference struct {
+	kind string
+	name string
+}
+
+type referencesAvoidDeprecatedTypesVisitor struct {
+	*visitor.BaseAddVisitor
+	types typeIndex
+	// references are the deprecated types keyed by the fields and the RPCs referencing them.
+	references map[parser.Visitee][]deprecatedTypeReference
+}
+
+// collectReferences collects the references in the body, descending into the nested messages.
+// deprecated tells whether or not the body is in a deprecated message, which may reference the deprecated types.
+func (v *referencesAvoidDeprecatedTypesVisitor) collectReferences(scope string, body []parser.Visitee, deprecated bool) {
+	for _, element := range body {
+		switch e := element.(type) {
+		case *parser.Message:
+			v.collectReferences(qualifiedName(scope, e.MessageName), e.MessageBody, deprecated || hasDeprecatedOption(e.MessageBody))
+		case *parser.GroupField:
+			v.collectReferences(qualifiedName(scope, e.GroupName), e.MessageBody, deprecated)
+		case *parser.Extend:
+			v.collectReferences(scope, e.ExtendBody, deprecated)
+		case *parser.Field:
+			if !deprecated && !isDeprecatedField(e.FieldOptions) {
+				v.reference(e, e.Type, scope)
+			}
+		case *parser.MapField:
+			if !deprecated && !isDeprecatedField(e.FieldOptions) {
+				v.reference(e, e.Type, scope)
+			}
+		case *parser.Oneof:
+			for _, f := range e.OneofFields {
+				if !deprecated && !isDeprecatedField(f.FieldOptions) {
+					v.reference(f, f.Type, scope)
+				}
+			}
+		case *parser.Service:
+			if hasDeprecatedOption(e.ServiceBody) {
+				continue
+			}
+			for _, element := range e.ServiceBody {
+				if rpc, ok := element.(*parser.RPC); ok && !isDeprecatedRPC(rpc) {
+					v.reference(rpc, rpc.RPCRequest.MessageType, scope)
+					v.reference(rpc, rpc.RPCResponse.MessageType, scope)
+				}
+			}
+		}
+	}
+}
+
+func (v *referencesAvoidDeprecatedTypesVisitor) reference(referrer parser.Visitee, typeName string, scope string) {
+	name, definition, ok := v.types.resolve(typeName, scope)
+	if !ok || !definition.deprecated {
+		return
+	}
+	v.references[referrer] = append(v.references[referrer], deprecatedTypeReference{
+		kind: definition.kind,
+		name: name,
+	})
+}
+
+// VisitField checks the field.
+func (v *referencesAvoidDeprecatedTypesVisitor) VisitField(field *parser.Field) bool {
+	for _, reference := range v.references[field] {
+		v.AddFailuref(field.Meta.Pos, "Field %q should not reference the deprecated %s %q", field.FieldName, reference.kind, reference.name)
+	}
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *referencesAvoidDeprecatedTypesVisitor) VisitMapField(field *parser.MapField) bool {
+	for _, reference := range v.references[field] {
+		v.AddFailuref(field.Meta.Pos, "Field %q should not reference the deprecated %s %q", field.MapName, reference.kind, reference.name)
+	}
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *referencesAvoidDeprecatedTypesVisitor) VisitOneofField(field *parser.OneofField) bool {
+	for _, reference := range v.references[field] {
+		v.AddFailuref(field.Meta.Pos, "Field %q should not reference the deprecated %s %q", field.FieldName, reference.kind, reference.name)
+	}
+	return false
+}
+
+// VisitRPC checks the rpc.
+func (v *referencesAvoidDeprecatedTypesVisitor) VisitRPC(rpc *parser.RPC) bool {
+	for _, reference := range v.references[rpc] {
+		v.AddFailuref(rpc.Meta.Pos, "RPC %q should not reference the deprecated %s %q", rpc.RPCName, reference.kind, reference.name)
+	}
+	return false
+}
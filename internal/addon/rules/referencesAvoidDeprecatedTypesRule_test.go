@@ -0,0 +1,276 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestReferencesAvoidDeprecatedTypesRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	deprecatedOption := &parser.Option{
+		OptionName: "deprecated",
+		Constant:   "true",
+	}
+	deprecatedFieldOptions := []*parser.FieldOption{
+		{
+			OptionName: "deprecated",
+			Constant:   "true",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto without references to deprecated types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Message{
+						MessageName: "Author",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Message{
+								MessageName: "Author",
+							},
+							&parser.Field{
+								Type:      "Author",
+								FieldName: "author",
+							},
+							&parser.Field{
+								Type:      "string",
+								FieldName: "title",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto with references to deprecated types from deprecated elements",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						EnumBody: []parser.Visitee{
+							deprecatedOption,
+						},
+					},
+					&parser.Message{
+						MessageName: "Author",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+							&parser.Message{
+								MessageName: "Profile",
+								MessageBody: []parser.Visitee{
+									&parser.Field{
+										Type:      "Genre",
+										FieldName: "genre",
+									},
+								},
+							},
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								Type:         "Author",
+								FieldName:    "author",
+								FieldOptions: deprecatedFieldOptions,
+							},
+						},
+					},
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetAuthor",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "Book",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "Author",
+								},
+								Options: []*parser.Option{
+									deprecatedOption,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with references to deprecated types",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Message{
+						MessageName: "Author",
+						MessageBody: []parser.Visitee{
+							deprecatedOption,
+							&parser.Enum{
+								EnumName: "Genre",
+								EnumBody: []parser.Visitee{
+									deprecatedOption,
+								},
+							},
+						},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								Type:      ".acme.library.v1.Author",
+								FieldName: "author",
+								Meta:      meta.Meta{Pos: pos},
+							},
+							&parser.MapField{
+								KeyType: "string",
+								Type:    "Author.Genre",
+								MapName: "genres",
+								Meta:    meta.Meta{Pos: pos},
+							},
+							&parser.Message{
+								MessageName: "Page",
+								MessageBody: []parser.Visitee{
+									&parser.Oneof{
+										OneofFields: []*parser.OneofField{
+											{
+												Type:      "Author",
+												FieldName: "author",
+												Meta:      meta.Meta{Pos: pos},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "UpdateAuthor",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "Author",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "Author",
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "REFERENCES_AVOID_DEPRECATED_TYPES", `Field "author" should not reference the deprecated message "acme.library.v1.Author"`),
+				report.Failuref(pos, "REFERENCES_AVOID_DEPRECATED_TYPES", `Field "genres" should not reference the deprecated enum "acme.library.v1.Author.Genre"`),
+				report.Failuref(pos, "REFERENCES_AVOID_DEPRECATED_TYPES", `Field "author" should not reference the deprecated message "acme.library.v1.Author"`),
+				report.Failuref(pos, "REFERENCES_AVOID_DEPRECATED_TYPES", `RPC "UpdateAuthor" should not reference the deprecated message "acme.library.v1.Author"`),
+				report.Failuref(pos, "REFERENCES_AVOID_DEPRECATED_TYPES", `RPC "UpdateAuthor" should not reference the deprecated message "acme.library.v1.Author"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewReferencesAvoidDeprecatedTypesRule(nil)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestReferencesAvoidDeprecatedTypesRule_Apply_protoSet(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("acme/library/v1", "rules", "deprecation", "types.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "deprecation", "book.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFile     file.ProtoFile
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:      "no failures without the linted set",
+			inputFile: setting_test.TestProtoFile("acme/library/v1", "rules", "deprecation", "book.proto"),
+		},
+		{
+			name:          "failures for the references to the deprecated types in the other file",
+			inputFile:     setting_test.TestProtoFile("acme/library/v1", "rules", "deprecation", "book.proto"),
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/book.proto",
+						Offset:   102,
+						Line:     8,
+						Column:   3,
+					},
+					"REFERENCES_AVOID_DEPRECATED_TYPES",
+					`Field "author" should not reference the deprecated message "acme.library.v1.Author"`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewReferencesAvoidDeprecatedTypesRule(test.inputProtoSet)
+
+			proto, err := test.inputFile.Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package rules
 import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -46,27 +47,73 @@ func (r RepeatedFieldNamesPluralizedRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r RepeatedFieldNamesPluralizedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A plural name tells that a repeated field holds a list.",
+		BadExample: `message Book {
+  repeated string author = 1;
+}`,
+		GoodExample: `message Book {
+  repeated string authors = 1;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#repeated-fields",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "plural_rules",
+				Description: "The additional rules to pluralize words.",
+			},
+			{
+				Name:        "singular_rules",
+				Description: "The additional rules to singularize words.",
+			},
+			{
+				Name:        "uncountable_rules",
+				Description: "The additional uncountable words.",
+			},
+			{
+				Name:        "irregular_rules",
+				Description: "The additional irregular words, the singular as the key and the plural as the value.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RepeatedFieldNamesPluralizedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &repeatedFieldNamesPluralizedCaseVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		pluralizeClient: newPluralizeClient(
+			r.pluralRules,
+			r.singularRules,
+			r.uncountableRules,
+			r.irregularRules,
+		),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+// newPluralizeClient creates a PluralizeClient with the additional rules.
+func newPluralizeClient(
+	pluralRules map[string]string,
+	singularRules map[string]string,
+	uncountableRules []string,
+	irregularRules map[string]string,
+) *strs.PluralizeClient {
 	c := strs.NewPluralizeClient()
-	for k, v := range r.pluralRules {
+	for k, v := range pluralRules {
 		c.AddPluralRule(k, v)
 	}
-	for k, v := range r.singularRules {
+	for k, v := range singularRules {
 		c.AddSingularRule(k, v)
 	}
-	for _, w := range r.uncountableRules {
+	for _, w := range uncountableRules {
 		c.AddUncountableRule(w)
 	}
-	for k, v := range r.irregularRules {
+	for k, v := range irregularRules {
 		c.AddIrregularRule(k, v)
 	}
-
-	v := &repeatedFieldNamesPluralizedCaseVisitor{
-		BaseAddVisitor:  visitor.NewBaseAddVisitor(r.ID()),
-		pluralizeClient: c,
-	}
-	return visitor.RunVisitor(v, proto, r.ID())
+	return c
 }
 
 type repeatedFieldNamesPluralizedCaseVisitor struct {
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// ReservedNotUsedRule verifies that no field or enum value uses a reserved number or name.
+// The enums with allow_alias are verified as well, and ENUM_ALLOW_ALIAS_AVOID_RESERVED reports them with the message about allow_alias.
+type ReservedNotUsedRule struct{}
+
+// NewReservedNotUsedRule creates a new ReservedNotUsedRule.
+func NewReservedNotUsedRule() ReservedNotUsedRule {
+	return ReservedNotUsedRule{}
+}
+
+// ID returns the ID of this rule.
+func (r ReservedNotUsedRule) ID() string {
+	return "RESERVED_NOT_USED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ReservedNotUsedRule) Purpose() string {
+	return "Verifies that no field or enum value uses a reserved number or name."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ReservedNotUsedRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r ReservedNotUsedRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "The reserved numbers and names belong to the deleted fields. Reusing them breaks the clients still sending the old fields.",
+		BadExample: `message Book {
+  reserved 2;
+  reserved "isbn";
+  string isbn = 2;
+}`,
+		GoodExample: `message Book {
+  reserved 2;
+  reserved "isbn";
+  string isbn13 = 3;
+}`,
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#reserved",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r ReservedNotUsedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &reservedNotUsedVisitor{
+		BaseAddVisitor:  visitor.NewBaseAddVisitor(r.ID()),
+		reservedNumbers: make(map[parser.Visitee]bool),
+		reservedNames:   make(map[parser.Visitee]bool),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type reservedNotUsedVisitor struct {
+	*visitor.BaseAddVisitor
+	reservedNumbers map[parser.Visitee]bool
+	reservedNames   map[parser.Visitee]bool
+}
+
+// VisitMessage collects the fields using the reserved numbers or names.
+func (v *reservedNotUsedVisitor) VisitMessage(message *parser.Message) bool {
+	v.collect(message.MessageBody, numberedFields(message.MessageBody), maxFieldNumber)
+	return true
+}
+
+// VisitGroupField checks the group field, and collects the fields using the reserved numbers or names.
+func (v *reservedNotUsedVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.check(field, "Field", field.GroupName, field.FieldNumber, field.Meta.Pos)
+	v.collect(field.MessageBody, numberedFields(field.MessageBody), maxFieldNumber)
+	return true
+}
+
+// VisitEnum collects the enum values using the reserved numbers or names.
+func (v *reservedNotUsedVisitor) VisitEnum(enum *parser.Enum) bool {
+	v.collect(enum.EnumBody, numberedEnumFields(enum.EnumBody), maxEnumNumber)
+	return true
+}
+
+// VisitField checks the field.
+func (v *reservedNotUsedVisitor) VisitField(field *parser.Field) bool {
+	v.check(field, "Field", field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *reservedNotUsedVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check(field, "Field", field.MapName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *reservedNotUsedVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check(field, "Field", field.FieldName, field.FieldNumber, field.Meta.Pos)
+	return false
+}
+
+// VisitEnumField checks the enum value.
+func (v *reservedNotUsedVisitor) VisitEnumField(field *parser.EnumField) bool {
+	v.check(field, "Enum value", field.Ident, field.Number, field.Meta.Pos)
+	return false
+}
+
+func (v *reservedNotUsedVisitor) collect(
+	body []parser.Visitee,
+	fields []numberedField,
+	max int,
+) {
+	reserved := newReservedSet(body, max)
+	for _, f := range fields {
+		if reserved.containsNumber(f.number) {
+			v.reservedNumbers[f.visitee] = true
+		}
+		if reserved.containsName(f.name) {
+			v.reservedNames[f.visitee] = true
+		}
+	}
+}
+
+func (v *reservedNotUsedVisitor) check(
+	visitee parser.Visitee,
+	kind string,
+	name string,
+	number string,
+	pos meta.Position,
+) {
+	if v.reservedNumbers[visitee] {
+		v.AddFailuref(pos, "%s %q uses the reserved number %s", kind, name, number)
+	}
+	if v.reservedNames[visitee] {
+		v.AddFailuref(pos, "%s %q uses the reserved name", kind, name)
+	}
+}
@@ -0,0 +1,192 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestReservedNotUsedRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto without the reserved fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2", End: "4"},
+								},
+							},
+							&parser.Reserved{
+								FieldNames: []string{`"isbn"`},
+							},
+							&parser.Field{
+								FieldName:   "title",
+								FieldNumber: "1",
+							},
+							&parser.Message{
+								MessageBody: []parser.Visitee{
+									&parser.Field{
+										FieldName:   "isbn",
+										FieldNumber: "2",
+									},
+								},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "1"},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_UNSPECIFIED",
+								Number: "0",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the reserved fields and enum values",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2", End: "max"},
+								},
+							},
+							&parser.Reserved{
+								FieldNames: []string{`"isbn"`},
+							},
+							&parser.Field{
+								FieldName:   "isbn",
+								FieldNumber: "1",
+								Meta:        meta.Meta{Pos: pos},
+							},
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName:   "author",
+										FieldNumber: "100",
+										Meta:        meta.Meta{Pos: pos},
+									},
+								},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "1"},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RESERVED_NOT_USED", `Field "isbn" uses the reserved name`),
+				report.Failuref(pos, "RESERVED_NOT_USED", `Field "author" uses the reserved number 100`),
+				report.Failuref(pos, "RESERVED_NOT_USED", `Enum value "COLOR_RED" uses the reserved number 1`),
+			},
+		},
+		{
+			name: "failures for the enum value above the max field number in the reserved range to max",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "5", End: "max"},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1000000000",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RESERVED_NOT_USED", `Enum value "COLOR_RED" uses the reserved number 1000000000`),
+			},
+		},
+		{
+			name: "failures for the enum with allow_alias",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Option{
+								OptionName: "allow_alias",
+								Constant:   "true",
+							},
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "1"},
+								},
+							},
+							&parser.EnumField{
+								Ident:  "COLOR_RED",
+								Number: "1",
+								Meta:   meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RESERVED_NOT_USED", `Enum value "COLOR_RED" uses the reserved number 1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewReservedNotUsedRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
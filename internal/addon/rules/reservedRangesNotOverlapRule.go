@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// ReservedRangesNotOverlapRule verifies that the reserved ranges and names don't overlap each other.
+type ReservedRangesNotOverlapRule struct{}
+
+// NewReservedRangesNotOverlapRule creates a new ReservedRangesNotOverlapRule.
+func NewReservedRangesNotOverlapRule() ReservedRangesNotOverlapRule {
+	return ReservedRangesNotOverlapRule{}
+}
+
+// ID returns the ID of this rule.
+func (r ReservedRangesNotOverlapRule) ID() string {
+	return "RESERVED_RANGES_NOT_OVERLAP"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ReservedRangesNotOverlapRule) Purpose() string {
+	return "Verifies that the reserved ranges and names don't overlap each other."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ReservedRangesNotOverlapRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r ReservedRangesNotOverlapRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "protoc rejects the overlapping reserved ranges. The duplicated names are harmless but show that the reserved statements are out of sync.",
+		BadExample:    "reserved 2 to 5, 4 to 8;",
+		GoodExample:   "reserved 2 to 8;",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/proto3#reserved",
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r ReservedRangesNotOverlapRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &reservedRangesNotOverlapVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		overlaps:       make(map[*parser.Reserved][]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type reservedRangesNotOverlapVisitor struct {
+	*visitor.BaseAddVisitor
+	// overlaps maps the reserved statement to the failure messages.
+	overlaps map[*parser.Reserved][]string
+}
+
+// VisitMessage collects the overlaps in the message.
+func (v *reservedRangesNotOverlapVisitor) VisitMessage(message *parser.Message) bool {
+	v.collect(message.MessageBody, maxFieldNumber)
+	return true
+}
+
+// VisitGroupField collects the overlaps in the group.
+func (v *reservedRangesNotOverlapVisitor) VisitGroupField(field *parser.GroupField) bool {
+	v.collect(field.MessageBody, maxFieldNumber)
+	return true
+}
+
+// VisitEnum collects the overlaps in the enum.
+func (v *reservedRangesNotOverlapVisitor) VisitEnum(enum *parser.Enum) bool {
+	v.collect(enum.EnumBody, maxEnumNumber)
+	return true
+}
+
+// VisitReserved checks the reserved statement.
+func (v *reservedRangesNotOverlapVisitor) VisitReserved(reserved *parser.Reserved) bool {
+	for _, message := range v.overlaps[reserved] {
+		v.AddFailuref(reserved.Meta.Pos, "%s", message)
+	}
+	return false
+}
+
+func (v *reservedRangesNotOverlapVisitor) collect(body []parser.Visitee, max int) {
+	var ranges []numberRange
+	names := make(map[string]bool)
+
+	for _, element := range body {
+		reserved, ok := element.(*parser.Reserved)
+		if !ok {
+			continue
+		}
+
+		for _, r := range parseRanges(reserved.Ranges, max) {
+			for _, previous := range ranges {
+				if r.begin <= previous.end && previous.begin <= r.end {
+					v.overlaps[reserved] = append(
+						v.overlaps[reserved],
+						fmt.Sprintf("Reserved range %s overlaps with %s", r, previous),
+					)
+					break
+				}
+			}
+			ranges = append(ranges, r)
+		}
+
+		for _, name := range reserved.FieldNames {
+			name = unquote(name)
+			if names[name] {
+				v.overlaps[reserved] = append(
+					v.overlaps[reserved],
+					fmt.Sprintf("Reserved name %q is already reserved", name),
+				)
+				continue
+			}
+			names[name] = true
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestReservedRangesNotOverlapRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the separate ranges",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2", End: "4"},
+									{Begin: "5"},
+								},
+							},
+							&parser.Reserved{
+								FieldNames: []string{`"foo"`, `"bar"`},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the overlapping ranges and names",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Message{
+						MessageBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "2", End: "5"},
+								},
+							},
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "4", End: "max"},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+							&parser.Reserved{
+								FieldNames: []string{`"foo"`, `"foo"`},
+								Meta:       meta.Meta{Pos: pos},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumBody: []parser.Visitee{
+							&parser.Reserved{
+								Ranges: []*parser.Range{
+									{Begin: "1"},
+									{Begin: "1"},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RESERVED_RANGES_NOT_OVERLAP", `Reserved range 4 to 536870911 overlaps with 2 to 5`),
+				report.Failuref(pos, "RESERVED_RANGES_NOT_OVERLAP", `Reserved name "foo" is already reserved`),
+				report.Failuref(pos, "RESERVED_RANGES_NOT_OVERLAP", `Reserved range 1 overlaps with 1`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewReservedRangesNotOverlapRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const (
+	defaultPageSizeField      = "page_size"
+	defaultPageTokenField     = "page_token"
+	defaultNextPageTokenField = "next_page_token"
+)
+
+// RPCListPaginationRule verifies that the requests of the List RPCs have page_size and page_token,
+// and that their responses have next_page_token.
+// The messages are looked up from the proto and the linted set. The streaming responses are not verified.
+// See https://google.aip.dev/158.
+type RPCListPaginationRule struct {
+	rpcs               []string
+	pageSizeField      string
+	pageTokenField     string
+	nextPageTokenField string
+	protoSet           *file.ParsedProtoSet
+}
+
+// NewRPCListPaginationRule creates a new RPCListPaginationRule.
+// rpcs are the patterns of the List RPC names. The standard methods like ListBooks are the List RPCs by default.
+// The messages are looked up from protoSet as well.
+func NewRPCListPaginationRule(
+	rpcs []string,
+	pageSizeField string,
+	pageTokenField string,
+	nextPageTokenField string,
+	protoSet *file.ParsedProtoSet,
+) RPCListPaginationRule {
+	if len(pageSizeField) == 0 {
+		pageSizeField = defaultPageSizeField
+	}
+	if len(pageTokenField) == 0 {
+		pageTokenField = defaultPageTokenField
+	}
+	if len(nextPageTokenField) == 0 {
+		nextPageTokenField = defaultNextPageTokenField
+	}
+	return RPCListPaginationRule{
+		rpcs:               rpcs,
+		pageSizeField:      pageSizeField,
+		pageTokenField:     pageTokenField,
+		nextPageTokenField: nextPageTokenField,
+		protoSet:           protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r RPCListPaginationRule) ID() string {
+	return "RPC_LIST_PAGINATION"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCListPaginationRule) Purpose() string {
+	return "Verifies that the List RPCs have the pagination fields page_size, page_token and next_page_token."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCListPaginationRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCListPaginationRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A List RPC without pagination returns every element at once. Adding the pagination later breaks the clients which expect the whole collection in a response.",
+		BadExample: `rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);
+
+message ListBooksRequest {
+  string parent = 1;
+}
+
+message ListBooksResponse {
+  repeated Book books = 1;
+}`,
+		GoodExample: `rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);
+
+message ListBooksRequest {
+  string parent = 1;
+  int32 page_size = 2;
+  string page_token = 3;
+}
+
+message ListBooksResponse {
+  repeated Book books = 1;
+  string next_page_token = 2;
+}`,
+		StyleGuideURL: "https://google.aip.dev/158",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "rpcs",
+				Description: "The patterns of the List RPC names, e.g. List* or Search*. The pattern syntax is that of path.Match. The default is the standard methods like ListBooks.",
+				Kind:        rule.OptionKindGlob,
+			},
+			{
+				Name:        "page_size_field",
+				Description: "The int32 field of the requests. The default is page_size.",
+			},
+			{
+				Name:        "page_token_field",
+				Description: "The string field of the requests. The default is page_token.",
+			},
+			{
+				Name:        "next_page_token_field",
+				Description: "The string field of the responses. The default is next_page_token.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCListPaginationRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	for _, pattern := range r.rpcs {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	messages := newMessageIndex(proto, r.protoSet)
+	v := newRPCMessageFieldsVisitor(r.ID())
+	forEachRPC(proto, r.protoSet, func(rpc *parser.RPC, pkg string) {
+		if !r.isListRPC(rpc.RPCName) {
+			return
+		}
+		request, _ := messages.resolve(rpc.RPCRequest.MessageType, pkg)
+		v.require(request, rpc.RPCName, "request", [][2]string{
+			{r.pageSizeField, "int32"},
+			{r.pageTokenField, "string"},
+		})
+		if rpc.RPCResponse.IsStream {
+			return
+		}
+		response, _ := messages.resolve(rpc.RPCResponse.MessageType, pkg)
+		v.require(response, rpc.RPCName, "response", [][2]string{
+			{r.nextPageTokenField, "string"},
+		})
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+func (r RPCListPaginationRule) isListRPC(rpcName string) bool {
+	if len(r.rpcs) == 0 {
+		return standardMethod(rpcName) == standardMethodList
+	}
+	return matchesAny(rpcName, r.rpcs)
+}
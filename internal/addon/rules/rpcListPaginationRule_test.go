@@ -0,0 +1,306 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCListPaginationRule_Apply(t *testing.T) {
+	messagePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   300,
+		Line:     15,
+		Column:   1,
+	}
+	fieldPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   400,
+		Line:     20,
+		Column:   3,
+	}
+
+	newField := func(typ, name string, isRepeated bool) *parser.Field {
+		return &parser.Field{
+			IsRepeated: isRepeated,
+			Type:       typ,
+			FieldName:  name,
+			Meta:       meta.Meta{Pos: fieldPos},
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		inputProto              *parser.Proto
+		inputRPCs               []string
+		inputPageSizeField      string
+		inputPageTokenField     string
+		inputNextPageTokenField string
+		wantFailures            []report.Failure
+		wantExistErr            bool
+	}{
+		{
+			name: "no failures for proto with the pagination fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse"),
+							newTestAIPRPC("Listen", "ListenRequest", "ListenResponse"),
+							newTestAIPRPC("ListShelves", "ListShelvesRequest", "UnknownResponse"),
+						},
+					},
+					&parser.Message{
+						MessageName: "ListBooksRequest",
+						MessageBody: []parser.Visitee{
+							newField("int32", "page_size", false),
+							&parser.Oneof{
+								OneofFields: []*parser.OneofField{
+									{Type: "string", FieldName: "page_token"},
+								},
+							},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListBooksResponse",
+						MessageBody: []parser.Visitee{
+							newField("string", "next_page_token", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListShelvesRequest",
+						MessageBody: []parser.Visitee{
+							newField("int32", "page_size", false),
+							newField("string", "page_token", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListenRequest",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+		},
+		{
+			name: "no failures for proto without the pagination of the streaming responses",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "ListBooks",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "ListBooksRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									IsStream:    true,
+									MessageType: "Book",
+								},
+							},
+						},
+					},
+					&parser.Message{
+						MessageName: "ListBooksRequest",
+						MessageBody: []parser.Visitee{
+							newField("int32", "page_size", false),
+							newField("string", "page_token", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto without the pagination fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse"),
+						},
+					},
+					&parser.Message{
+						MessageName: "ListBooksRequest",
+						MessageBody: []parser.Visitee{
+							newField("uint32", "page_size", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListBooksResponse",
+						MessageBody: []parser.Visitee{
+							newField("string", "next_page_token", true),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(messagePos, "RPC_LIST_PAGINATION", `Message "ListBooksRequest" is the request of the RPC "ListBooks" and should have the field "page_token" of string`),
+				report.Failuref(fieldPos, "RPC_LIST_PAGINATION", `Field "page_size" of the request of the RPC "ListBooks" should be int32, but it is uint32`),
+				report.Failuref(fieldPos, "RPC_LIST_PAGINATION", `Field "next_page_token" of the response of the RPC "ListBooks" should be string, but it is repeated string`),
+			},
+		},
+		{
+			name: "failures for proto without the custom pagination fields",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("SearchBooks", "SearchBooksRequest", "SearchBooksResponse"),
+							newTestAIPRPC("ListBooks", "ListBooksRequest", "ListBooksResponse"),
+						},
+					},
+					&parser.Message{
+						MessageName: "SearchBooksRequest",
+						MessageBody: []parser.Visitee{
+							newField("int32", "max_results", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "SearchBooksResponse",
+						MessageBody: []parser.Visitee{
+							newField("string", "next_cursor", false),
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "ListBooksRequest",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+			inputRPCs:               []string{"Search*"},
+			inputPageSizeField:      "max_results",
+			inputPageTokenField:     "cursor",
+			inputNextPageTokenField: "next_cursor",
+			wantFailures: []report.Failure{
+				report.Failuref(messagePos, "RPC_LIST_PAGINATION", `Message "SearchBooksRequest" is the request of the RPC "SearchBooks" and should have the field "cursor" of string`),
+			},
+		},
+		{
+			name: "an error for the invalid pattern",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{},
+			},
+			inputRPCs:    []string{"List["},
+			wantExistErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCListPaginationRule(
+				test.inputRPCs,
+				test.inputPageSizeField,
+				test.inputPageTokenField,
+				test.inputNextPageTokenField,
+				nil,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestRPCListPaginationRule_Apply_protoSet(t *testing.T) {
+	protoSet := file.NewParsedProtoSet([]file.ProtoFile{
+		setting_test.TestProtoFile("acme/library/v1", "rules", "rpcListPagination", "service.proto"),
+		setting_test.TestProtoFile("acme/library/v1", "rules", "rpcListPagination", "messages.proto"),
+	})
+
+	tests := []struct {
+		name          string
+		inputFile     file.ProtoFile
+		inputProtoSet *file.ParsedProtoSet
+		wantFailures  []report.Failure
+	}{
+		{
+			name:      "no failures without the linted set",
+			inputFile: setting_test.TestProtoFile("acme/library/v1", "rules", "rpcListPagination", "messages.proto"),
+		},
+		{
+			name:          "no failures for the file of the RPC without the messages",
+			inputFile:     setting_test.TestProtoFile("acme/library/v1", "rules", "rpcListPagination", "service.proto"),
+			inputProtoSet: protoSet,
+		},
+		{
+			name:          "failures for the messages of the RPC in the other file",
+			inputFile:     setting_test.TestProtoFile("acme/library/v1", "rules", "rpcListPagination", "messages.proto"),
+			inputProtoSet: protoSet,
+			wantFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/messages.proto",
+						Offset:   46,
+						Line:     5,
+						Column:   1,
+					},
+					"RPC_LIST_PAGINATION",
+					`Message "ListBooksRequest" is the request of the RPC "ListBooks" and should have the field "page_token" of string`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "acme/library/v1/messages.proto",
+						Offset:   96,
+						Line:     7,
+						Column:   3,
+					},
+					"RPC_LIST_PAGINATION",
+					`Field "page_size" of the request of the RPC "ListBooks" should be int32, but it is int64`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCListPaginationRule(nil, "", "", "", test.inputProtoSet)
+
+			proto, err := test.inputFile.Parse(false)
+			if err != nil {
+				t.Errorf(err.Error())
+				return
+			}
+
+			got, err := rule.Apply(proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// messageFieldRequirement is a field which the request or the response of an RPC must have.
+type messageFieldRequirement struct {
+	rpcName   string
+	role      string
+	fieldName string
+	fieldType string
+}
+
+// rpcMessageFieldsVisitor verifies that the messages have the fields of the requirements.
+// The requirements are keyed by the messages and the fields because the RPCs can be in the other files.
+type rpcMessageFieldsVisitor struct {
+	*visitor.BaseAddVisitor
+	requirements      map[*parser.Message][]messageFieldRequirement
+	fieldRequirements map[parser.Visitee]messageFieldRequirement
+}
+
+func newRPCMessageFieldsVisitor(ruleID string) *rpcMessageFieldsVisitor {
+	return &rpcMessageFieldsVisitor{
+		BaseAddVisitor:    visitor.NewBaseAddVisitor(ruleID),
+		requirements:      make(map[*parser.Message][]messageFieldRequirement),
+		fieldRequirements: make(map[parser.Visitee]messageFieldRequirement),
+	}
+}
+
+// require adds the fields of the names and the types to the requirements of the message.
+// The message is ignored if it is not found, or it already has the requirements of the role.
+func (v *rpcMessageFieldsVisitor) require(message *parser.Message, rpcName string, role string, fields [][2]string) {
+	if message == nil {
+		return
+	}
+	for _, r := range v.requirements[message] {
+		if r.role == role {
+			return
+		}
+	}
+
+	for _, field := range fields {
+		r := messageFieldRequirement{
+			rpcName:   rpcName,
+			role:      role,
+			fieldName: field[0],
+			fieldType: field[1],
+		}
+		v.requirements[message] = append(v.requirements[message], r)
+
+		for _, element := range message.MessageBody {
+			switch e := element.(type) {
+			case *parser.Field:
+				if e.FieldName == r.fieldName {
+					v.fieldRequirements[e] = r
+				}
+			case *parser.MapField:
+				if e.MapName == r.fieldName {
+					v.fieldRequirements[e] = r
+				}
+			case *parser.Oneof:
+				for _, f := range e.OneofFields {
+					if f.FieldName == r.fieldName {
+						v.fieldRequirements[f] = r
+					}
+				}
+			}
+		}
+	}
+}
+
+// VisitMessage checks the message has the fields.
+func (v *rpcMessageFieldsVisitor) VisitMessage(message *parser.Message) bool {
+	types := messageFieldTypes(message)
+	for _, r := range v.requirements[message] {
+		if _, ok := types[r.fieldName]; !ok {
+			v.AddFailuref(
+				message.Meta.Pos,
+				"Message %q is the %s of the RPC %q and should have the field %q of %s",
+				message.MessageName, r.role, r.rpcName, r.fieldName, r.fieldType,
+			)
+		}
+	}
+	return true
+}
+
+// VisitField checks the type of the field.
+func (v *rpcMessageFieldsVisitor) VisitField(field *parser.Field) bool {
+	typ := fullMessageType(field.Type)
+	if field.IsRepeated {
+		typ = "repeated " + typ
+	}
+	v.checkType(field, typ, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the type of the map field.
+func (v *rpcMessageFieldsVisitor) VisitMapField(field *parser.MapField) bool {
+	v.checkType(field, "map<"+field.KeyType+", "+field.Type+">", field.Meta.Pos)
+	return false
+}
+
+// VisitOneofField checks the type of the oneof field.
+func (v *rpcMessageFieldsVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.checkType(field, fullMessageType(field.Type), field.Meta.Pos)
+	return false
+}
+
+func (v *rpcMessageFieldsVisitor) checkType(field parser.Visitee, typ string, pos meta.Position) {
+	r, ok := v.fieldRequirements[field]
+	if !ok || typ == r.fieldType {
+		return
+	}
+	v.AddFailuref(
+		pos,
+		"Field %q of the %s of the RPC %q should be %s, but it is %s",
+		r.fieldName, r.role, r.rpcName, r.fieldType, typ,
+	)
+}
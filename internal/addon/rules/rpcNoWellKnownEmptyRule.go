@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const wellKnownEmpty = "google.protobuf.Empty"
+
+// RPCNoWellKnownEmptyRule verifies that the requests and responses don't use google.protobuf.Empty.
+type RPCNoWellKnownEmptyRule struct{}
+
+// NewRPCNoWellKnownEmptyRule creates a new RPCNoWellKnownEmptyRule.
+func NewRPCNoWellKnownEmptyRule() RPCNoWellKnownEmptyRule {
+	return RPCNoWellKnownEmptyRule{}
+}
+
+// ID returns the ID of this rule.
+func (r RPCNoWellKnownEmptyRule) ID() string {
+	return "RPC_NO_WELL_KNOWN_EMPTY"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCNoWellKnownEmptyRule) Purpose() string {
+	return "Verifies that the requests and responses don't use google.protobuf.Empty."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCNoWellKnownEmptyRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCNoWellKnownEmptyRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:   "google.protobuf.Empty can never have a field, so an RPC using it can't be extended without breaking its clients. An empty dedicated message can be.",
+		BadExample:  `rpc DeleteBook(DeleteBookRequest) returns (google.protobuf.Empty);`,
+		GoodExample: `rpc DeleteBook(DeleteBookRequest) returns (DeleteBookResponse);`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCNoWellKnownEmptyRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &rpcNoWellKnownEmptyVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type rpcNoWellKnownEmptyVisitor struct {
+	*visitor.BaseAddVisitor
+}
+
+// VisitRPC checks the rpc.
+func (v *rpcNoWellKnownEmptyVisitor) VisitRPC(rpc *parser.RPC) bool {
+	v.check(rpc.RPCName, "request", rpc.RPCRequest.MessageType, rpc.RPCRequest.Meta.Pos)
+	v.check(rpc.RPCName, "response", rpc.RPCResponse.MessageType, rpc.RPCResponse.Meta.Pos)
+	return false
+}
+
+func (v *rpcNoWellKnownEmptyVisitor) check(rpcName, kind, messageType string, pos meta.Position) {
+	if fullMessageType(messageType) == wellKnownEmpty {
+		v.AddFailuref(pos, "RPC %q should not use %s as the %s. Use a dedicated message instead", rpcName, wellKnownEmpty, kind)
+	}
+}
@@ -0,0 +1,96 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCNoWellKnownEmptyRule_Apply(t *testing.T) {
+	requestPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   20,
+	}
+	responsePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   120,
+		Line:     5,
+		Column:   40,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the dedicated messages",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "DeleteBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "DeleteBookRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "DeleteBookResponse",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with google.protobuf.Empty",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "DeleteBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "google.protobuf.Empty",
+									Meta:        meta.Meta{Pos: requestPos},
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: ".google.protobuf.Empty",
+									Meta:        meta.Meta{Pos: responsePos},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(requestPos, "RPC_NO_WELL_KNOWN_EMPTY", `RPC "DeleteBook" should not use google.protobuf.Empty as the request. Use a dedicated message instead`),
+				report.Failuref(responsePos, "RPC_NO_WELL_KNOWN_EMPTY", `RPC "DeleteBook" should not use google.protobuf.Empty as the response. Use a dedicated message instead`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCNoWellKnownEmptyRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const (
+	defaultRequestSuffix  = "Request"
+	defaultResponseSuffix = "Response"
+)
+
+// RPCRequestResponseNamingRule verifies that the request and response messages are named after the RPC (e.g. "GetFooRequest", "GetFooResponse").
+type RPCRequestResponseNamingRule struct {
+	requestSuffix  string
+	responseSuffix string
+}
+
+// NewRPCRequestResponseNamingRule creates a new RPCRequestResponseNamingRule.
+func NewRPCRequestResponseNamingRule(
+	requestSuffix string,
+	responseSuffix string,
+) RPCRequestResponseNamingRule {
+	if len(requestSuffix) == 0 {
+		requestSuffix = defaultRequestSuffix
+	}
+	if len(responseSuffix) == 0 {
+		responseSuffix = defaultResponseSuffix
+	}
+	return RPCRequestResponseNamingRule{
+		requestSuffix:  requestSuffix,
+		responseSuffix: responseSuffix,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r RPCRequestResponseNamingRule) ID() string {
+	return "RPC_REQUEST_RESPONSE_NAMING"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCRequestResponseNamingRule) Purpose() string {
+	return `Verifies that the request and response messages are named after the RPC (e.g. "GetFooRequest", "GetFooResponse").`
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCRequestResponseNamingRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCRequestResponseNamingRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:   "Naming the messages after the RPC makes the pair easy to find and lets each RPC evolve its messages without affecting the others.",
+		BadExample:  `rpc GetBook(BookQuery) returns (Book);`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (GetBookResponse);`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "request_suffix",
+				Description: `The suffix of the request message names. The default is "Request".`,
+			},
+			{
+				Name:        "response_suffix",
+				Description: `The suffix of the response message names. The default is "Response".`,
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCRequestResponseNamingRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &rpcRequestResponseNamingVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		requestSuffix:  r.requestSuffix,
+		responseSuffix: r.responseSuffix,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type rpcRequestResponseNamingVisitor struct {
+	*visitor.BaseAddVisitor
+	requestSuffix  string
+	responseSuffix string
+}
+
+// VisitRPC checks the rpc.
+func (v *rpcRequestResponseNamingVisitor) VisitRPC(rpc *parser.RPC) bool {
+	want := rpc.RPCName + v.requestSuffix
+	if name := messageTypeName(rpc.RPCRequest.MessageType); name != want {
+		v.AddFailuref(rpc.RPCRequest.Meta.Pos, "RPC %q request %q should be named %q", rpc.RPCName, name, want)
+	}
+
+	want = rpc.RPCName + v.responseSuffix
+	if name := messageTypeName(rpc.RPCResponse.MessageType); name != want {
+		v.AddFailuref(rpc.RPCResponse.Meta.Pos, "RPC %q response %q should be named %q", rpc.RPCName, name, want)
+	}
+	return false
+}
+
+// messageTypeName returns the message name without the package of the message type.
+func messageTypeName(messageType string) string {
+	return messageType[strings.LastIndex(messageType, ".")+1:]
+}
+
+// fullMessageType returns the message type without the leading dot of the fully-qualified name.
+func fullMessageType(messageType string) string {
+	return strings.TrimPrefix(messageType, ".")
+}
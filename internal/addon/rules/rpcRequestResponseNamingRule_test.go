@@ -0,0 +1,127 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCRequestResponseNamingRule_Apply(t *testing.T) {
+	requestPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   20,
+	}
+	responsePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   120,
+		Line:     5,
+		Column:   40,
+	}
+
+	tests := []struct {
+		name                string
+		inputProto          *parser.Proto
+		inputRequestSuffix  string
+		inputResponseSuffix string
+		wantFailures        []report.Failure
+	}{
+		{
+			name: "no failures for proto with the messages named after the RPC",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "GetBookRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "library.v1.GetBookResponse",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the messages not named after the RPC",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "BookQuery",
+									Meta:        meta.Meta{Pos: requestPos},
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: ".library.v1.Book",
+									Meta:        meta.Meta{Pos: responsePos},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(requestPos, "RPC_REQUEST_RESPONSE_NAMING", `RPC "GetBook" request "BookQuery" should be named "GetBookRequest"`),
+				report.Failuref(responsePos, "RPC_REQUEST_RESPONSE_NAMING", `RPC "GetBook" response "Book" should be named "GetBookResponse"`),
+			},
+		},
+		{
+			name: "failures for proto with the messages not named with the specified suffixes",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "GetBookReq",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "GetBookResponse",
+									Meta:        meta.Meta{Pos: responsePos},
+								},
+							},
+						},
+					},
+				},
+			},
+			inputRequestSuffix:  "Req",
+			inputResponseSuffix: "Res",
+			wantFailures: []report.Failure{
+				report.Failuref(responsePos, "RPC_REQUEST_RESPONSE_NAMING", `RPC "GetBook" response "GetBookResponse" should be named "GetBookRes"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCRequestResponseNamingRule(
+				test.inputRequestSuffix,
+				test.inputResponseSuffix,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// RPCRequestResponseUniqueRule verifies that the request and response messages are not shared across RPCs.
+// The well-known types are verified by RPC_NO_WELL_KNOWN_EMPTY.
+type RPCRequestResponseUniqueRule struct{}
+
+// NewRPCRequestResponseUniqueRule creates a new RPCRequestResponseUniqueRule.
+func NewRPCRequestResponseUniqueRule() RPCRequestResponseUniqueRule {
+	return RPCRequestResponseUniqueRule{}
+}
+
+// ID returns the ID of this rule.
+func (r RPCRequestResponseUniqueRule) ID() string {
+	return "RPC_REQUEST_RESPONSE_UNIQUE"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCRequestResponseUniqueRule) Purpose() string {
+	return "Verifies that the request and response messages are not shared across RPCs."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCRequestResponseUniqueRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCRequestResponseUniqueRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A message shared by some RPCs can't get a field for one of them without leaking it into the others.",
+		BadExample: `rpc GetBook(BookRequest) returns (Book);
+rpc DeleteBook(BookRequest) returns (Book);`,
+		GoodExample: `rpc GetBook(GetBookRequest) returns (GetBookResponse);
+rpc DeleteBook(DeleteBookRequest) returns (DeleteBookResponse);`,
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCRequestResponseUniqueRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	v := &rpcRequestResponseUniqueVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		firstUsers:     make(map[string]*parser.RPC),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type rpcRequestResponseUniqueVisitor struct {
+	*visitor.BaseAddVisitor
+	firstUsers map[string]*parser.RPC
+}
+
+// OnStart collects the first RPC using each message.
+func (v *rpcRequestResponseUniqueVisitor) OnStart(proto *parser.Proto) error {
+	for _, element := range proto.ProtoBody {
+		service, ok := element.(*parser.Service)
+		if !ok {
+			continue
+		}
+		for _, body := range service.ServiceBody {
+			rpc, ok := body.(*parser.RPC)
+			if !ok {
+				continue
+			}
+			for _, messageType := range []string{rpc.RPCRequest.MessageType, rpc.RPCResponse.MessageType} {
+				name := fullMessageType(messageType)
+				if _, ok := v.firstUsers[name]; !ok {
+					v.firstUsers[name] = rpc
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// VisitRPC checks the rpc.
+func (v *rpcRequestResponseUniqueVisitor) VisitRPC(rpc *parser.RPC) bool {
+	request := rpc.RPCRequest.MessageType
+	response := rpc.RPCResponse.MessageType
+	v.check(rpc, "request", request, rpc.RPCRequest.Meta.Pos)
+	v.check(rpc, "response", response, rpc.RPCResponse.Meta.Pos)
+
+	if fullMessageType(request) == fullMessageType(response) && !isWellKnownType(request) {
+		v.AddFailuref(rpc.RPCResponse.Meta.Pos, "RPC %q uses %q as both the request and the response", rpc.RPCName, response)
+	}
+	return false
+}
+
+func (v *rpcRequestResponseUniqueVisitor) check(rpc *parser.RPC, kind, messageType string, pos meta.Position) {
+	if isWellKnownType(messageType) {
+		return
+	}
+	first, ok := v.firstUsers[fullMessageType(messageType)]
+	if !ok || first == rpc {
+		return
+	}
+	v.AddFailuref(pos, "RPC %q %s %q is already used by the RPC %q", rpc.RPCName, kind, messageType, first.RPCName)
+}
+
+// isWellKnownType decides whether or not the message type is one of google.protobuf.
+func isWellKnownType(messageType string) bool {
+	return strings.HasPrefix(fullMessageType(messageType), "google.protobuf.")
+}
@@ -0,0 +1,129 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCRequestResponseUniqueRule_Apply(t *testing.T) {
+	requestPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   20,
+	}
+	responsePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   120,
+		Line:     5,
+		Column:   40,
+	}
+
+	tests := []struct {
+		name         string
+		inputProto   *parser.Proto
+		wantFailures []report.Failure
+	}{
+		{
+			name: "no failures for proto with the unique messages",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "GetBookRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "google.protobuf.Empty",
+								},
+							},
+							&parser.RPC{
+								RPCName: "DeleteBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "DeleteBookRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "google.protobuf.Empty",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the shared messages",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "BookRequest",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "Book",
+								},
+							},
+						},
+					},
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "DeleteBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: ".BookRequest",
+									Meta:        meta.Meta{Pos: requestPos},
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "Book",
+									Meta:        meta.Meta{Pos: responsePos},
+								},
+							},
+							&parser.RPC{
+								RPCName: "UpdateBook",
+								RPCRequest: &parser.RPCRequest{
+									MessageType: "UpdateBook",
+								},
+								RPCResponse: &parser.RPCResponse{
+									MessageType: "UpdateBook",
+									Meta:        meta.Meta{Pos: responsePos},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(requestPos, "RPC_REQUEST_RESPONSE_UNIQUE", `RPC "DeleteBook" request ".BookRequest" is already used by the RPC "GetBook"`),
+				report.Failuref(responsePos, "RPC_REQUEST_RESPONSE_UNIQUE", `RPC "DeleteBook" response "Book" is already used by the RPC "GetBook"`),
+				report.Failuref(responsePos, "RPC_REQUEST_RESPONSE_UNIQUE", `RPC "UpdateBook" uses "UpdateBook" as both the request and the response`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCRequestResponseUniqueRule()
+
+			got, err := rule.Apply(test.inputProto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+const defaultUpdateMaskField = "update_mask"
+
+// RPCUpdateFieldMaskRule verifies that the requests of the Update RPCs have update_mask of google.protobuf.FieldMask.
+// The requests are looked up from the proto and the linted set.
+// See https://google.aip.dev/134.
+type RPCUpdateFieldMaskRule struct {
+	rpcs            []string
+	updateMaskField string
+	protoSet        *file.ParsedProtoSet
+}
+
+// NewRPCUpdateFieldMaskRule creates a new RPCUpdateFieldMaskRule.
+// rpcs are the patterns of the Update RPC names. The standard methods like UpdateBook are the Update RPCs by default.
+// The requests are looked up from protoSet as well.
+func NewRPCUpdateFieldMaskRule(
+	rpcs []string,
+	updateMaskField string,
+	protoSet *file.ParsedProtoSet,
+) RPCUpdateFieldMaskRule {
+	if len(updateMaskField) == 0 {
+		updateMaskField = defaultUpdateMaskField
+	}
+	return RPCUpdateFieldMaskRule{
+		rpcs:            rpcs,
+		updateMaskField: updateMaskField,
+		protoSet:        protoSet,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r RPCUpdateFieldMaskRule) ID() string {
+	return "RPC_UPDATE_FIELD_MASK"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCUpdateFieldMaskRule) Purpose() string {
+	return "Verifies that the Update RPCs have the field update_mask of google.protobuf.FieldMask."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCUpdateFieldMaskRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCUpdateFieldMaskRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Without a field mask, an update can't tell a field to clear from a field the client doesn't know, and the older clients erase the newer fields.",
+		BadExample: `message UpdateBookRequest {
+  Book book = 1;
+}`,
+		GoodExample: `message UpdateBookRequest {
+  Book book = 1;
+  google.protobuf.FieldMask update_mask = 2;
+}`,
+		StyleGuideURL: "https://google.aip.dev/134",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "rpcs",
+				Description: "The patterns of the Update RPC names, e.g. Update* or Patch*. The pattern syntax is that of path.Match. The default is the standard methods like UpdateBook.",
+				Kind:        rule.OptionKindGlob,
+			},
+			{
+				Name:        "update_mask_field",
+				Description: "The google.protobuf.FieldMask field of the requests. The default is update_mask.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCUpdateFieldMaskRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	for _, pattern := range r.rpcs {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	messages := newMessageIndex(proto, r.protoSet)
+	v := newRPCMessageFieldsVisitor(r.ID())
+	forEachRPC(proto, r.protoSet, func(rpc *parser.RPC, pkg string) {
+		if !r.isUpdateRPC(rpc.RPCName) {
+			return
+		}
+		request, _ := messages.resolve(rpc.RPCRequest.MessageType, pkg)
+		v.require(request, rpc.RPCName, "request", [][2]string{
+			{r.updateMaskField, "google.protobuf.FieldMask"},
+		})
+	})
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+func (r RPCUpdateFieldMaskRule) isUpdateRPC(rpcName string) bool {
+	if len(r.rpcs) == 0 {
+		return standardMethod(rpcName) == standardMethodUpdate
+	}
+	return matchesAny(rpcName, r.rpcs)
+}
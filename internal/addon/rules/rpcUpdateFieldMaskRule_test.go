@@ -0,0 +1,158 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCUpdateFieldMaskRule_Apply(t *testing.T) {
+	messagePos := meta.Position{
+		Filename: "example.proto",
+		Offset:   300,
+		Line:     15,
+		Column:   1,
+	}
+	fieldPos := meta.Position{
+		Filename: "example.proto",
+		Offset:   400,
+		Line:     20,
+		Column:   3,
+	}
+
+	tests := []struct {
+		name                 string
+		inputProto           *parser.Proto
+		inputRPCs            []string
+		inputUpdateMaskField string
+		wantFailures         []report.Failure
+		wantExistErr         bool
+	}{
+		{
+			name: "no failures for proto with the field masks",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Package{
+						Name: "acme.library.v1",
+					},
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book"),
+							newTestAIPRPC("UpdateShelf", "acme.library.v1.UpdateShelfRequest", "Shelf"),
+							newTestAIPRPC("Updater", "UpdaterRequest", "UpdaterResponse"),
+						},
+					},
+					&parser.Message{
+						MessageName: "UpdateBookRequest",
+						MessageBody: []parser.Visitee{
+							&parser.Field{Type: "google.protobuf.FieldMask", FieldName: "update_mask", Meta: meta.Meta{Pos: fieldPos}},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "UpdateShelfRequest",
+						MessageBody: []parser.Visitee{
+							&parser.Field{Type: ".google.protobuf.FieldMask", FieldName: "update_mask", Meta: meta.Meta{Pos: fieldPos}},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "UpdaterRequest",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto without the field masks",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("UpdateBook", "UpdateBookRequest", "Book"),
+							newTestAIPRPC("UpdateShelf", "UpdateShelfRequest", "Shelf"),
+						},
+					},
+					&parser.Message{
+						MessageName: "UpdateBookRequest",
+						Meta:        meta.Meta{Pos: messagePos},
+					},
+					&parser.Message{
+						MessageName: "UpdateShelfRequest",
+						MessageBody: []parser.Visitee{
+							&parser.Field{Type: "string", FieldName: "update_mask", IsRepeated: true, Meta: meta.Meta{Pos: fieldPos}},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(messagePos, "RPC_UPDATE_FIELD_MASK", `Message "UpdateBookRequest" is the request of the RPC "UpdateBook" and should have the field "update_mask" of google.protobuf.FieldMask`),
+				report.Failuref(fieldPos, "RPC_UPDATE_FIELD_MASK", `Field "update_mask" of the request of the RPC "UpdateShelf" should be google.protobuf.FieldMask, but it is repeated string`),
+			},
+		},
+		{
+			name: "failures for proto without the custom field masks",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceBody: []parser.Visitee{
+							newTestAIPRPC("PatchBook", "PatchBookRequest", "Book"),
+						},
+					},
+					&parser.Message{
+						MessageName: "PatchBookRequest",
+						MessageBody: []parser.Visitee{
+							&parser.Field{Type: "google.protobuf.FieldMask", FieldName: "update_mask", Meta: meta.Meta{Pos: fieldPos}},
+						},
+						Meta: meta.Meta{Pos: messagePos},
+					},
+				},
+			},
+			inputRPCs:            []string{"Patch*"},
+			inputUpdateMaskField: "field_mask",
+			wantFailures: []report.Failure{
+				report.Failuref(messagePos, "RPC_UPDATE_FIELD_MASK", `Message "PatchBookRequest" is the request of the RPC "PatchBook" and should have the field "field_mask" of google.protobuf.FieldMask`),
+			},
+		},
+		{
+			name: "an error for the invalid pattern",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{},
+			},
+			inputRPCs:    []string{"Update["},
+			wantExistErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCUpdateFieldMaskRule(
+				test.inputRPCs,
+				test.inputUpdateMaskField,
+				nil,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
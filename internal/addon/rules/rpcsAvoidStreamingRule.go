@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+// RPCsAvoidStreamingRule verifies that client-, server- and bidi-streaming RPCs are only used by the allowed services and RPCs.
+type RPCsAvoidStreamingRule struct {
+	allowedServices []string
+	allowedRPCs     []string
+	requireComment  bool
+}
+
+// NewRPCsAvoidStreamingRule creates a new RPCsAvoidStreamingRule.
+func NewRPCsAvoidStreamingRule(
+	allowedServices []string,
+	allowedRPCs []string,
+	requireComment bool,
+) RPCsAvoidStreamingRule {
+	return RPCsAvoidStreamingRule{
+		allowedServices: allowedServices,
+		allowedRPCs:     allowedRPCs,
+		requireComment:  requireComment,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r RPCsAvoidStreamingRule) ID() string {
+	return "RPCS_AVOID_STREAMING"
+}
+
+// Purpose returns the purpose of this rule.
+func (r RPCsAvoidStreamingRule) Purpose() string {
+	return "Verifies that client-, server- and bidi-streaming RPCs are only used by the allowed services and RPCs."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r RPCsAvoidStreamingRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r RPCsAvoidStreamingRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "Streaming RPCs are harder to load balance, retry and proxy than unary ones, so they are kept to the services built for them. Their termination semantics aren't obvious from the signature and deserve a comment.",
+		BadExample: `service BookService {
+  rpc ListBooks(ListBooksRequest) returns (stream Book);
+}`,
+		GoodExample: `service BookService {
+  rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "allowed_services",
+				Description: "The service name patterns allowed to have streaming RPCs. The pattern syntax is that of path.Match, e.g. *StreamService.",
+				Kind:        rule.OptionKindGlob,
+			},
+			{
+				Name:        "allowed_rpcs",
+				Description: "The RPC name patterns allowed to stream. The pattern syntax is that of path.Match, e.g. Watch*.",
+				Kind:        rule.OptionKindGlob,
+			},
+			{
+				Name:        "require_comment",
+				Description: "Requires a comment on the allowed streaming RPCs to document how the stream terminates. The default is false.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r RPCsAvoidStreamingRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	for _, patterns := range [][]string{r.allowedServices, r.allowedRPCs} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	v := &rpcsAvoidStreamingVisitor{
+		BaseAddVisitor:  visitor.NewBaseAddVisitor(r.ID()),
+		allowedServices: r.allowedServices,
+		allowedRPCs:     r.allowedRPCs,
+		requireComment:  r.requireComment,
+		serviceNames:    make(map[*parser.RPC]string),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type rpcsAvoidStreamingVisitor struct {
+	*visitor.BaseAddVisitor
+	allowedServices []string
+	allowedRPCs     []string
+	requireComment  bool
+	serviceNames    map[*parser.RPC]string
+}
+
+// OnStart collects the service names of the RPCs.
+func (v *rpcsAvoidStreamingVisitor) OnStart(proto *parser.Proto) error {
+	for _, element := range proto.ProtoBody {
+		service, ok := element.(*parser.Service)
+		if !ok {
+			continue
+		}
+		for _, body := range service.ServiceBody {
+			if rpc, ok := body.(*parser.RPC); ok {
+				v.serviceNames[rpc] = service.ServiceName
+			}
+		}
+	}
+	return nil
+}
+
+// VisitRPC checks the rpc.
+func (v *rpcsAvoidStreamingVisitor) VisitRPC(rpc *parser.RPC) bool {
+	kind := streamingKind(rpc)
+	if kind == "" {
+		return false
+	}
+
+	if !matchesAny(v.serviceNames[rpc], v.allowedServices) && !matchesAny(rpc.RPCName, v.allowedRPCs) {
+		v.AddFailuref(rpc.Meta.Pos, "RPC %q should avoid %s streaming", rpc.RPCName, kind)
+		return false
+	}
+
+	if v.requireComment && len(rpc.Comments) == 0 && rpc.InlineComment == nil {
+		v.AddFailuref(rpc.Meta.Pos, "Streaming RPC %q should have a comment documenting how the stream terminates", rpc.RPCName)
+	}
+	return false
+}
+
+// streamingKind returns "client", "server" or "bidi". It returns empty if the rpc is unary.
+func streamingKind(rpc *parser.RPC) string {
+	switch {
+	case rpc.RPCRequest.IsStream && rpc.RPCResponse.IsStream:
+		return "bidi"
+	case rpc.RPCRequest.IsStream:
+		return "client"
+	case rpc.RPCResponse.IsStream:
+		return "server"
+	}
+	return ""
+}
+
+// matchesAny decides whether or not the name matches any of the patterns.
+// Apply only checks the patterns by matching them against the empty name, so a malformed pattern
+// which path.Match reports against this name is treated as not matching.
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package rules_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestRPCsAvoidStreamingRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+
+	newRPC := func(name string, requestStream, responseStream bool, comments []*parser.Comment) *parser.RPC {
+		return &parser.RPC{
+			RPCName: name,
+			RPCRequest: &parser.RPCRequest{
+				IsStream:    requestStream,
+				MessageType: name + "Request",
+			},
+			RPCResponse: &parser.RPCResponse{
+				IsStream:    responseStream,
+				MessageType: name + "Response",
+			},
+			Comments: comments,
+			Meta:     meta.Meta{Pos: pos},
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		inputProto           *parser.Proto
+		inputAllowedServices []string
+		inputAllowedRPCs     []string
+		inputRequireComment  bool
+		wantFailures         []report.Failure
+		wantExistErr         bool
+	}{
+		{
+			name: "no failures for proto with unary RPCs",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "BookService",
+						ServiceBody: []parser.Visitee{
+							newRPC("GetBook", false, false, nil),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with streaming RPCs",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "BookService",
+						ServiceBody: []parser.Visitee{
+							newRPC("UploadBooks", true, false, nil),
+							newRPC("ListBooks", false, true, nil),
+							newRPC("Chat", true, true, nil),
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `RPC "UploadBooks" should avoid client streaming`),
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `RPC "ListBooks" should avoid server streaming`),
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `RPC "Chat" should avoid bidi streaming`),
+			},
+		},
+		{
+			name: "failures for proto with the allowed streaming RPCs without a comment",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "ChatStreamService",
+						ServiceBody: []parser.Visitee{
+							newRPC("Chat", true, true, []*parser.Comment{
+								{
+									Raw: "// Chat ends when the client closes the stream.",
+								},
+							}),
+							newRPC("Listen", false, true, nil),
+						},
+					},
+					&parser.Service{
+						ServiceName: "BookService",
+						ServiceBody: []parser.Visitee{
+							newRPC("WatchBooks", false, true, nil),
+							newRPC("ListBooks", false, true, nil),
+						},
+					},
+				},
+			},
+			inputAllowedServices: []string{"*StreamService"},
+			inputAllowedRPCs:     []string{"Watch*"},
+			inputRequireComment:  true,
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `Streaming RPC "Listen" should have a comment documenting how the stream terminates`),
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `Streaming RPC "WatchBooks" should have a comment documenting how the stream terminates`),
+				report.Failuref(pos, "RPCS_AVOID_STREAMING", `RPC "ListBooks" should avoid server streaming`),
+			},
+		},
+		{
+			name: "an error for the invalid pattern",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{},
+			},
+			inputAllowedRPCs: []string{"Watch["},
+			wantExistErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewRPCsAvoidStreamingRule(
+				test.inputAllowedServices,
+				test.inputAllowedRPCs,
+				test.inputRequireComment,
+			)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -38,6 +39,22 @@ func (r RPCsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r RPCsHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:  "A comment on each RPC documents its behavior and errors for the API consumers.",
+		BadExample: "rpc GetBook(GetBookRequest) returns (Book);",
+		GoodExample: `// GetBook returns the book.
+rpc GetBook(GetBookRequest) returns (Book);`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the RPC. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RPCsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &rpcsHaveCommentVisitor{
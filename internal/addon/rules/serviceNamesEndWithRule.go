@@ -6,6 +6,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -36,6 +37,21 @@ func (r ServiceNamesEndWithRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r ServiceNamesEndWithRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:   "A common suffix like Service distinguishes the services from the messages.",
+		BadExample:  "service Library {}",
+		GoodExample: "service LibraryService {}",
+		Options: []rule.OptionDoc{
+			{
+				Name:        "text",
+				Description: "The suffix of the service names.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServiceNamesEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &serviceNamesEndWithVisitor{
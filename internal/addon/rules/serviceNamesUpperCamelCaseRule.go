@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/strs"
 	"github.com/tyhal/protolint/linter/visitor"
 )
@@ -32,6 +33,16 @@ func (r ServiceNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r ServiceNamesUpperCamelCaseRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:     "The style guide uses CamelCase with an initial capital for service names.",
+		BadExample:    "service library_service {}",
+		GoodExample:   "service LibraryService {}",
+		StyleGuideURL: "https://developers.google.com/protocol-buffers/docs/style#services",
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServiceNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &serviceNamesUpperCamelCaseVisitor{
@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -38,6 +39,22 @@ func (r ServicesHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r ServicesHaveCommentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:  "A comment on each service documents the responsibility of the API.",
+		BadExample: "service LibraryService {}",
+		GoodExample: `// LibraryService manages the books.
+service LibraryService {}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "should_follow_golang_style",
+				Description: "Requires the comment to start with the name of the service. The default is false.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServicesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &servicesHaveCommentVisitor{
@@ -0,0 +1,301 @@
+package rules
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// minSpellingWordLength is the length of the shortest word to check.
+	// The shorter ones are mostly the abbreviations like id and v in v1.
+	minSpellingWordLength = 3
+	// maxSpellingSuggestions is the maximum number of the words suggested for the unknown word.
+	maxSpellingSuggestions = 3
+)
+
+// bundledSpellingWords are the words of the bundled English word list.
+var bundledSpellingWords = newWordSet(strings.Fields(englishWords))
+
+// spellingSuffixes and spellingPrefixes are the affixes of the inflected and derived words, and the replacements restoring their bases.
+// For example, the base of validation is valid or validate.
+var (
+	spellingSuffixes = []struct {
+		suffix       string
+		replacements []string
+	}{
+		{"ies", []string{"y"}},
+		{"ves", []string{"f", "fe"}},
+		{"ied", []string{"y"}},
+		{"ier", []string{"y"}},
+		{"iest", []string{"y"}},
+		{"ily", []string{"y"}},
+		{"ally", []string{""}},
+		{"s", []string{""}},
+		{"es", []string{""}},
+		{"ed", []string{"", "e"}},
+		{"ing", []string{"", "e"}},
+		{"er", []string{"", "e"}},
+		{"est", []string{"", "e"}},
+		{"or", []string{"", "e"}},
+		{"ly", []string{""}},
+		{"ion", []string{"", "e"}},
+		{"ation", []string{"", "e"}},
+		{"ity", []string{"", "e"}},
+		{"ment", []string{""}},
+		{"ness", []string{""}},
+		{"ance", []string{"", "e"}},
+		{"ence", []string{"", "e"}},
+		{"ancy", []string{"ant"}},
+		{"ency", []string{"ent"}},
+		{"able", []string{"", "e"}},
+		{"ible", []string{"", "e"}},
+		{"al", []string{"", "e"}},
+		{"ful", []string{""}},
+		{"less", []string{""}},
+		{"ize", []string{"", "e"}},
+		{"ise", []string{"", "e"}},
+		{"ive", []string{"", "e"}},
+		{"ship", []string{""}},
+	}
+	spellingPrefixes = []string{
+		"anti", "auto", "co", "de", "dis", "il", "im", "in", "inter", "ir", "micro", "mis", "multi", "non", "over", "pre", "re", "sub", "super", "un", "under",
+	}
+)
+
+// spellingDictionary looks up the words in the bundled word list and the user dictionaries.
+type spellingDictionary struct {
+	userWords map[string]bool
+}
+
+// spellingDictionaries caches the dictionaries by the words and the paths of the dictionary files.
+// The rules are created for each linted file, so that the files would be read again for each one otherwise.
+var spellingDictionaries = struct {
+	sync.Mutex
+	m map[string]spellingDictionary
+}{
+	m: make(map[string]spellingDictionary),
+}
+
+// loadSpellingDictionary returns the cached spellingDictionary, creating it on the first call.
+func loadSpellingDictionary(words []string, dictionaries []string) (spellingDictionary, error) {
+	key := strings.Join(words, "\n") + "\x00" + strings.Join(dictionaries, "\n")
+
+	spellingDictionaries.Lock()
+	defer spellingDictionaries.Unlock()
+	if d, ok := spellingDictionaries.m[key]; ok {
+		return d, nil
+	}
+	d, err := newSpellingDictionary(words, dictionaries)
+	if err != nil {
+		return spellingDictionary{}, err
+	}
+	spellingDictionaries.m[key] = d
+	return d, nil
+}
+
+// newSpellingDictionary creates a spellingDictionary with the words and the words in the dictionary files.
+func newSpellingDictionary(words []string, dictionaries []string) (spellingDictionary, error) {
+	userWords := newWordSet(words)
+	for _, path := range dictionaries {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return spellingDictionary{}, err
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			userWords[strings.ToLower(line)] = true
+		}
+	}
+	return spellingDictionary{
+		userWords: userWords,
+	}, nil
+}
+
+func newWordSet(words []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range words {
+		set[strings.ToLower(word)] = true
+	}
+	return set
+}
+
+func (d spellingDictionary) has(word string) bool {
+	return bundledSpellingWords[word] || d.userWords[word]
+}
+
+// contains decides whether or not the word in lower case is in the dictionary.
+// The inflected and derived forms of the words, and the compounds of two words like username are in the dictionary as well.
+func (d spellingDictionary) contains(word string) bool {
+	if d.derives(word, 2) {
+		return true
+	}
+	for i := minSpellingWordLength; i <= len(word)-minSpellingWordLength; i++ {
+		if d.has(word[:i]) && d.derives(word[i:], 2) {
+			return true
+		}
+	}
+	return false
+}
+
+// derives decides whether or not the word is in the dictionary, or derived from the word in it with up to depth affixes.
+func (d spellingDictionary) derives(word string, depth int) bool {
+	if d.has(word) {
+		return true
+	}
+	if depth == 0 {
+		return false
+	}
+	for _, base := range wordBases(word) {
+		if 2 <= len(base) && d.derives(base, depth-1) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordBases returns the candidates of the base of the word with an affix removed.
+func wordBases(word string) []string {
+	var bases []string
+	for _, s := range spellingSuffixes {
+		if !strings.HasSuffix(word, s.suffix) {
+			continue
+		}
+		stem := strings.TrimSuffix(word, s.suffix)
+		for _, replacement := range s.replacements {
+			bases = append(bases, stem+replacement)
+		}
+		// The consonant is doubled like stopped and running.
+		if n := len(stem); 2 <= n && stem[n-1] == stem[n-2] {
+			bases = append(bases, stem[:n-1])
+		}
+	}
+	for _, prefix := range spellingPrefixes {
+		if strings.HasPrefix(word, prefix) && minSpellingWordLength <= len(word)-len(prefix) {
+			bases = append(bases, strings.TrimPrefix(word, prefix))
+		}
+	}
+	return bases
+}
+
+// suggest returns the words in the dictionary closest to the word, up to maxSpellingSuggestions.
+// The words within the edit distance of one, or two for the words longer than four letters, are suggested.
+// The inflected forms are suggested for the inflected word, e.g. labels for lables.
+func (d spellingDictionary) suggest(word string) []string {
+	maxDistance := 1
+	if 4 < len(word) {
+		maxDistance = 2
+	}
+
+	type candidate struct {
+		word     string
+		distance int
+	}
+	var candidates []candidate
+	for _, suffix := range []string{"", "s", "ed", "ing"} {
+		stem := strings.TrimSuffix(word, suffix)
+		if stem == word && suffix != "" || len(stem) < minSpellingWordLength {
+			continue
+		}
+		for _, set := range []map[string]bool{bundledSpellingWords, d.userWords} {
+			for w := range set {
+				if len(w)-len(stem) > maxDistance || len(stem)-len(w) > maxDistance {
+					continue
+				}
+				w = inflect(w, suffix)
+				if distance := editDistance(word, w); distance <= maxDistance {
+					candidates = append(candidates, candidate{word: w, distance: distance})
+				}
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		// The typos are rare in the first letter.
+		if iFirst, jFirst := candidates[i].word[0] == word[0], candidates[j].word[0] == word[0]; iFirst != jFirst {
+			return iFirst
+		}
+		return candidates[i].word < candidates[j].word
+	})
+
+	var suggestions []string
+	for _, c := range candidates {
+		if 0 < len(suggestions) && suggestions[len(suggestions)-1] == c.word {
+			continue
+		}
+		if len(suggestions) == maxSpellingSuggestions {
+			break
+		}
+		suggestions = append(suggestions, c.word)
+	}
+	return suggestions
+}
+
+// inflect returns the word with the suffix of s, ed or ing, following the spelling rules like libraries and created.
+func inflect(word string, suffix string) string {
+	consonantY := 2 <= len(word) && strings.HasSuffix(word, "y") && !strings.ContainsAny(word[len(word)-2:len(word)-1], "aeiou")
+	switch suffix {
+	case "s":
+		switch {
+		case consonantY:
+			return strings.TrimSuffix(word, "y") + "ies"
+		case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+			strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+			return word + "es"
+		}
+	case "ed":
+		switch {
+		case consonantY:
+			return strings.TrimSuffix(word, "y") + "ied"
+		case strings.HasSuffix(word, "e"):
+			return word + "d"
+		}
+	case "ing":
+		if strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "ee") {
+			return strings.TrimSuffix(word, "e") + "ing"
+		}
+	}
+	return word + suffix
+}
+
+// editDistance returns the number of the insertions, the deletions, the substitutions and the transpositions of the adjacent letters
+// to change a into b, which is known as the optimal string alignment distance.
+func editDistance(a string, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if 1 < i && 1 < j && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
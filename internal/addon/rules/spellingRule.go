@@ -0,0 +1,234 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
+	"github.com/tyhal/protolint/linter/strs"
+	"github.com/tyhal/protolint/linter/visitor"
+)
+
+var (
+	// spellingCommentIgnored matches the parts of the comments which are not the prose, e.g. `book_id`, URLs, paths and emails.
+	spellingCommentIgnored = regexp.MustCompile("`[^`]*`|\\S*[/@]\\S*")
+	// spellingCommentToken matches the words of the comments, which can be the identifiers like page_token or GetBook.
+	spellingCommentToken = regexp.MustCompile(`[A-Za-z0-9_]+('[A-Za-z]+)?`)
+	// spellingWordPart matches the words in the part of the identifier, e.g. HTTPRequest in HTTPRequest and v in v2.
+	spellingWordPart = regexp.MustCompile(`[A-Z]*[a-z]+|[A-Z]+`)
+)
+
+// SpellingRule verifies that the words of the names and the comments are spelled correctly.
+// The names of the messages, fields, oneofs, enums, enum values, services and RPCs are split into the words,
+// and the words are looked up in the bundled English word list and the user dictionaries.
+// The words shorter than three letters and the words in upper case in the comments, like ISBN, are not checked.
+type SpellingRule struct {
+	words        []string
+	dictionaries []string
+}
+
+// NewSpellingRule creates a new SpellingRule.
+// words are added to the bundled word list, and dictionaries are the paths of the files with a word on each line.
+// The dictionary files are read on the first Apply and cached by their paths, so that they are read once for all the linted files.
+func NewSpellingRule(
+	words []string,
+	dictionaries []string,
+) SpellingRule {
+	return SpellingRule{
+		words:        words,
+		dictionaries: dictionaries,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r SpellingRule) ID() string {
+	return "SPELLING"
+}
+
+// Purpose returns the purpose of this rule.
+func (r SpellingRule) Purpose() string {
+	return "Verifies that the words of the names and the comments are spelled correctly."
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r SpellingRule) IsOfficial() bool {
+	return false
+}
+
+// Doc returns the long-form documentation of this rule.
+func (r SpellingRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale: "A typo in a name becomes a permanent part of the API, since renaming it breaks the JSON and the generated code. The product and domain terms are added to the dictionaries.",
+		BadExample: `message Book {
+  // The titel of the book.
+  string titel = 1;
+}`,
+		GoodExample: `message Book {
+  // The title of the book.
+  string title = 1;
+}`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "words",
+				Description: "The words added to the bundled English word list, e.g. the product names. They are case-insensitive.",
+			},
+			{
+				Name:        "dictionaries",
+				Description: "The paths of the dictionary files, which have a word on each line. The relative paths are resolved against the directory of the config file. The empty lines and the lines starting with # are ignored.",
+			},
+		},
+	}
+}
+
+// Apply applies the rule to the proto.
+func (r SpellingRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	dictionary, err := loadSpellingDictionary(r.words, r.dictionaries)
+	if err != nil {
+		return nil, err
+	}
+	v := &spellingVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID()),
+		dictionary:     dictionary,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type spellingVisitor struct {
+	*visitor.BaseAddVisitor
+	dictionary spellingDictionary
+}
+
+func (v *spellingVisitor) check(
+	kind string,
+	name string,
+	comments []*parser.Comment,
+	inlineComment *parser.Comment,
+	pos meta.Position,
+) {
+	v.checkWords(splitIdentifier(name), "name", kind, name, pos)
+
+	if inlineComment != nil {
+		comments = append(append([]*parser.Comment{}, comments...), inlineComment)
+	}
+	var words []string
+	text := spellingCommentIgnored.ReplaceAllString(commentText(comments), " ")
+	for _, token := range spellingCommentToken.FindAllString(text, -1) {
+		if strings.Contains(token, "'") {
+			token = strings.TrimSuffix(strings.TrimSuffix(token, "'s"), "'S")
+		}
+		if strings.Contains(token, "'") {
+			words = append(words, strings.ToLower(token))
+			continue
+		}
+		if strings.ToUpper(token) == token && !strings.Contains(token, "_") {
+			continue
+		}
+		words = append(words, splitIdentifier(token)...)
+	}
+	v.checkWords(words, "comment", kind, name, pos)
+}
+
+func (v *spellingVisitor) checkWords(words []string, where string, kind string, name string, pos meta.Position) {
+	reported := make(map[string]bool)
+	for _, word := range words {
+		if len(word) < minSpellingWordLength || reported[word] || v.dictionary.contains(word) {
+			continue
+		}
+		reported[word] = true
+
+		var suggestion string
+		if candidates := v.dictionary.suggest(word); 0 < len(candidates) {
+			suggestion = ", did you mean " + joinQuoted(candidates) + "?"
+		}
+		v.AddFailuref(pos, "Word %q in the %s of the %s %q is not in the dictionaries%s", word, where, kind, name, suggestion)
+	}
+}
+
+// splitIdentifier splits the identifier like book_title, BookTitle or BOOK_TITLE into the words in lower case.
+// The acronyms like HTTP in HTTPRequest are split off and the digits are dropped.
+func splitIdentifier(name string) []string {
+	var words []string
+	for _, part := range strs.SplitSnakeCaseWord(strings.Trim(name, "_")) {
+		for _, camel := range strs.SplitCamelCaseWord(part) {
+			for _, word := range spellingWordPart.FindAllString(camel, -1) {
+				if i := strings.LastIndexAny(word, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"); 0 < i && i < len(word)-1 {
+					words = append(words, strings.ToLower(word[:i]))
+					word = word[i:]
+				}
+				words = append(words, strings.ToLower(word))
+			}
+		}
+	}
+	return words
+}
+
+// joinQuoted returns the words quoted and joined like "a", "b" or "c".
+func joinQuoted(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = fmt.Sprintf("%q", word)
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+// VisitMessage checks the message.
+func (v *spellingVisitor) VisitMessage(message *parser.Message) bool {
+	v.check("message", message.MessageName, message.Comments, message.InlineComment, message.Meta.Pos)
+	return true
+}
+
+// VisitField checks the field.
+func (v *spellingVisitor) VisitField(field *parser.Field) bool {
+	v.check("field", field.FieldName, field.Comments, field.InlineComment, field.Meta.Pos)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *spellingVisitor) VisitMapField(field *parser.MapField) bool {
+	v.check("field", field.MapName, field.Comments, field.InlineComment, field.Meta.Pos)
+	return false
+}
+
+// VisitOneof checks the oneof.
+func (v *spellingVisitor) VisitOneof(oneof *parser.Oneof) bool {
+	v.check("oneof", oneof.OneofName, oneof.Comments, oneof.InlineComment, oneof.Meta.Pos)
+	return true
+}
+
+// VisitOneofField checks the oneof field.
+func (v *spellingVisitor) VisitOneofField(field *parser.OneofField) bool {
+	v.check("field", field.FieldName, field.Comments, field.InlineComment, field.Meta.Pos)
+	return false
+}
+
+// VisitEnum checks the enum.
+func (v *spellingVisitor) VisitEnum(enum *parser.Enum) bool {
+	v.check("enum", enum.EnumName, enum.Comments, enum.InlineComment, enum.Meta.Pos)
+	return true
+}
+
+// VisitEnumField checks the enum value.
+func (v *spellingVisitor) VisitEnumField(field *parser.EnumField) bool {
+	v.check("enum value", field.Ident, field.Comments, field.InlineComment, field.Meta.Pos)
+	return false
+}
+
+// VisitService checks the service.
+func (v *spellingVisitor) VisitService(service *parser.Service) bool {
+	v.check("service", service.ServiceName, service.Comments, service.InlineComment, service.Meta.Pos)
+	return true
+}
+
+// VisitRPC checks the rpc.
+func (v *spellingVisitor) VisitRPC(rpc *parser.RPC) bool {
+	v.check("RPC", rpc.RPCName, rpc.Comments, rpc.InlineComment, rpc.Meta.Pos)
+	return false
+}
@@ -0,0 +1,252 @@
+package rules_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/addon/rules"
+	"github.com/tyhal/protolint/internal/setting_test"
+	"github.com/tyhal/protolint/linter/report"
+)
+
+func TestSpellingRule_Apply(t *testing.T) {
+	pos := meta.Position{
+		Filename: "example.proto",
+		Offset:   100,
+		Line:     5,
+		Column:   10,
+	}
+	tests := []struct {
+		name              string
+		inputWords        []string
+		inputDictionaries []string
+		inputProto        *parser.Proto
+		wantFailures      []report.Failure
+		wantExistErr      bool
+	}{
+		{
+			name: "no failures for proto with the correct spellings",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "LibraryService",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// Manages the shelves and the books, see https://example.com/docs.",
+							},
+						},
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "ListBooks",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Lists the books. Returns NOT_FOUND if the shelf doesn't exist.",
+									},
+								},
+							},
+						},
+					},
+					&parser.Message{
+						MessageName: "HTTPRequest",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// The request's headers are `x_goog_bar` like in RFC 7230.",
+							},
+						},
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName: "created_by_username",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// protolint:disable:next SPELLING",
+									},
+									{
+										Raw: "/**\n * The user who created it.\n */",
+									},
+								},
+								InlineComment: &parser.Comment{Raw: "// Unmodifiable, e.g. jdoe@example.com."},
+							},
+							&parser.MapField{
+								MapName: "labels",
+							},
+							&parser.Oneof{
+								OneofName: "source",
+								OneofFields: []*parser.OneofField{
+									{
+										FieldName: "uri_v2",
+									},
+								},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident: "GENRE_NON_FICTION",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Biographies, memoirs and essays.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failures for proto with the misspellings",
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "LibarySerivce",
+						ServiceBody: []parser.Visitee{
+							&parser.RPC{
+								RPCName: "GetBook",
+								Comments: []*parser.Comment{
+									{
+										Raw: "// Recieves the book.",
+									},
+								},
+								Meta: meta.Meta{Pos: pos},
+							},
+						},
+						Meta: meta.Meta{Pos: pos},
+					},
+					&parser.Message{
+						MessageName: "Book",
+						MessageBody: []parser.Visitee{
+							&parser.Field{
+								FieldName:     "titel_titel",
+								InlineComment: &parser.Comment{Raw: "// The titel."},
+								Meta:          meta.Meta{Pos: pos},
+							},
+							&parser.Field{
+								FieldName: "lables",
+								Meta:      meta.Meta{Pos: pos},
+							},
+							&parser.Field{
+								FieldName: "qwertyuiop",
+								Meta:      meta.Meta{Pos: pos},
+							},
+						},
+					},
+					&parser.Enum{
+						EnumName: "Genre",
+						EnumBody: []parser.Visitee{
+							&parser.EnumField{
+								Ident: "GENRE_UNSPECIFEID",
+								Meta:  meta.Meta{Pos: pos},
+							},
+						},
+					},
+				},
+			},
+			wantFailures: []report.Failure{
+				report.Failuref(pos, "SPELLING", `Word "libary" in the name of the service "LibarySerivce" is not in the dictionaries, did you mean "library" or "binary"?`),
+				report.Failuref(pos, "SPELLING", `Word "serivce" in the name of the service "LibarySerivce" is not in the dictionaries, did you mean "service", "serve" or "derive"?`),
+				report.Failuref(pos, "SPELLING", `Word "recieves" in the comment of the RPC "GetBook" is not in the dictionaries, did you mean "receives", "relieves" or "receive"?`),
+				report.Failuref(pos, "SPELLING", `Word "titel" in the name of the field "titel_titel" is not in the dictionaries, did you mean "title", "tie" or "tier"?`),
+				report.Failuref(pos, "SPELLING", `Word "titel" in the comment of the field "titel_titel" is not in the dictionaries, did you mean "title", "tie" or "tier"?`),
+				report.Failuref(pos, "SPELLING", `Word "lables" in the name of the field "lables" is not in the dictionaries, did you mean "labels", "ables" or "tables"?`),
+				report.Failuref(pos, "SPELLING", `Word "qwertyuiop" in the name of the field "qwertyuiop" is not in the dictionaries`),
+				report.Failuref(pos, "SPELLING", `Word "unspecifeid" in the name of the enum value "GENRE_UNSPECIFEID" is not in the dictionaries, did you mean "unspecified"?`),
+			},
+		},
+		{
+			name:              "no failures for proto with the words in the user dictionaries",
+			inputWords:        []string{"LIBARY"},
+			inputDictionaries: []string{setting_test.TestDataPath("rules", "spelling", "dictionary.txt")},
+			inputProto: &parser.Proto{
+				ProtoBody: []parser.Visitee{
+					&parser.Service{
+						ServiceName: "LibaryService",
+						Comments: []*parser.Comment{
+							{
+								Raw: "// The Acme service for the librarians.",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:              "an error for the missing dictionary",
+			inputDictionaries: []string{setting_test.TestDataPath("rules", "spelling", "missing.txt")},
+			inputProto:        &parser.Proto{},
+			wantExistErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule := rules.NewSpellingRule(test.inputWords, test.inputDictionaries)
+
+			got, err := rule.Apply(test.inputProto)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestSpellingRule_Apply_readsDictionariesOnce(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spelling")
+	if err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	dictionary := filepath.Join(dir, "dictionary.txt")
+	if err := ioutil.WriteFile(dictionary, []byte("qwertyuiop\n"), 0644); err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+
+	proto := &parser.Proto{
+		ProtoBody: []parser.Visitee{
+			&parser.Message{
+				MessageName: "Qwertyuiop",
+			},
+		},
+	}
+	for i, file := range []string{"first.proto", "second.proto", "third.proto"} {
+		if i == 1 {
+			// The rules for the following files must not read the changed dictionary again.
+			if err := ioutil.WriteFile(dictionary, nil, 0644); err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+		}
+
+		rule := rules.NewSpellingRule(nil, []string{dictionary})
+		got, err := rule.Apply(proto)
+		if err != nil {
+			t.Errorf("got err %v, but want nil", err)
+			return
+		}
+		if len(got) != 0 {
+			t.Errorf("%s: got %v, but want no failures", file, got)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package rules
+
+// englishWords is the word list bundled with the SPELLING rule.
+// It has the common English words mostly in their base forms, and the terms of the APIs and the computing.
+// The inflected forms like books, created and validation are derived by spellingDictionary.
+const englishWords = `
+a abandon ability able abort about above absence absent absolute absorb abstract abuse academic accelerate accent
+accept access accessible accident accommodate accompany accomplish accord according account accountant accumulate accuracy accurate
+accuse achieve achievement acid acknowledge acquire acquisition across act action active activity actor actual acute
+adapt adapter adaptor add addition additional address adequate adjacent adjust administer administration administrator admin admire
+admission admit adopt adult advance advantage adventure adverse advertise advertisement advice advise adviser advisor advocate
+affair affect affiliate afford afraid after afternoon afterward afterwards again against age agency agenda agent
+aggregate aggregation aggressive ago agree agreement agriculture ahead aid aim air aircraft airline airport alarm
+album alert algorithm alias align alignment alike alive all allocate allocation allow allowance almost alone along
+alongside already also alter alternate alternative although altitude altogether always amaze ambition amend amendment among
+amongst amount analog analogue analysis analyst analytic analytics analyze analyse ancestor anchor ancient and angle
+angry animal animate animation anniversary announce annual anonymous another answer anticipate anxiety any anybody anymore
+anyone anything anyway anywhere apart apartment apparent appeal appear appearance append appendix apple applicable applicant
+application apply appoint appointment appreciate approach appropriate approval approve approximate approximately arbitrary archive area
+argue argument arise arm army around arrange arrangement array arrest arrival arrive arrow art article
+artifact artefact artificial artist as ascend ascending aside ask aspect assemble assembly assert assertion
+assess assessment asset assign assignee assignment assist assistance assistant associate association assume assumption assurance assure
+async asynchronous at atomic attach attachment attack attempt attend attendance attendee attention attitude attorney attract
+attribute attribution auction audience audio audit auditor augment author authority authorization authorize auto automate
+automatic automatically automation automobile autonomous availability available avatar average avoid await awake award aware
+away awful axis
+back backend background backlog backoff backup backward backwards bad badge bag balance ball ban
+band bandwidth bank banner bar bare barely barrier base baseline basic basically basis basket batch
+bath battery battle bay be beach bear beat beautiful beauty because become bed bedroom
+before begin beginning behalf behave behavior behaviour behind being belief believe bell belong below belt
+bench benchmark bend beneath benefit beside besides best bet beta better between beyond bias bid
+big bill billing billion bin binary bind binding biography bird birth birthday bit bitmap bitrate
+black blank blend blind blob block blocker blog blood blow blue board boat body
+bold bond bone bonus book booking boolean boost boot border borrow boss both bottle bottom
+bounce bound boundary box brain branch brand bread break breakdown breakpoint breath brick bridge brief
+briefly bright brilliant bring broad broadcast broker brother brown browse browser bucket budget buffer bug
+build builder building bulk bullet bunch bundle burden bureau burn burst bus business busy
+but button buy buyer by bypass byte
+cabinet cache cake calculate calculation calendar call callback caller calm camera camp campaign campus can
+cancel cancellation candidate canonical capability capable capacity capital caption capture car carbon card care
+career careful carefully carousel carrier carry cart cascade case cash cast casual cat catalog catalogue
+catch category cause caution cell center centre central century certain certainly certificate chain chair chairman
+challenge chamber champion chance change changelog channel chapter char character characteristic charge chart chat cheap
+check checkbox checkout checkpoint checksum cheese chef chemical chest chief child childhood children chip choice
+choose chunk church cipher circle circuit circumstance citation cite citizen city civil claim class classic
+classical classification classify clause clean cleanup clear clearly clerk click client climate climb clock clone
+close closely closure cloud club clue cluster coach coast code codec coerce coffee cognitive cohort
+coin cold collaborate collaborator collapse colleague collect collection collective collector college collision color colour column
+combination combine come comfort comfortable command comment commerce commercial commission commit commitment committee common
+commonly communicate communication community compact company comparable compare comparison compatibility compatible compensate compete competition
+competitive competitor compile compiler complain complaint complete completely completion complex complexity compliance complicated comply
+component compose composite composition compound comprehensive compress compression comprise compromise compute computer concat concatenate
+concentrate concept concern concerning concert conclude conclusion concrete concurrency concurrent condition conduct conference confidence
+confident config configuration configure confirm confirmation conflict confuse confusion connect connection connector consensus consent
+consequence consequently conservative consider considerable consideration consist consistency consistent console constant constantly constitute constraint
+construct construction constructor consult consultant consume consumer consumption contact contain container content contest context
+continent continue continuous contract contrast contribute contribution contributor control controller convenient convention conventional conversation
+conversion convert converter convince cookie cool coordinate coordinator cope copy copyright core corner corporate
+corporation correct correction correctly correlate correlation correspond correspondent corresponding corrupt corruption cost could council
+count counter counterpart country county couple coupon courage course court cousin cover coverage crash
+crawl crawler create creation creative creator credential credit crew crime criminal crisis criteria criterion critical
+criticism cron crop cross crowd crucial cry crypto cryptographic cultural culture cup currency current currently
+cursor curve custom customer customize cut cutoff cycle
+daemon daily damage dance danger dangerous dark dashboard data database datacenter datapoint dataset date datetime
+daughter day dead deadline deal dealer dear death debate debit debt debug debugger decade decide
+decimal decision deck declare decline decode decoder decompress decorate decorator decrease decrement decrypt dedicated dedupe
+deduplicate deep deeply default defeat defend defense defence define definitely definition degrade degree delay delegate
+delete deletion deliberately delimiter deliver delivery demand demo democracy demonstrate denial denied deny department
+departure depend dependency dependent deploy deployment deposit deprecate deprecation depth deputy derive descend descendant descending
+describe description descriptor desert deserialize design designer desire desk desktop despite destination destroy destruction
+detail detect detection determine deterministic develop developer development deviation device diagnose diagnostic dialog dialogue diameter
+dictionary did die diet differ difference different differently difficult difficulty diff digest digit digital
+dimension dinner direct direction directive directly director directory dirty disable disallow disappear disaster disc
+discard disclose discount discover discovery discuss discussion disease disk dismiss dispatch dispatcher display dispose
+dispute distance distinct distinction distinguish distribute distribution district disturb diverse divide dividend division do
+doc dock doctor document documentation dog dollar domain domestic dominant door dot double doubt
+down download downstream dozen draft drag drain drama dramatic draw drawing dream dress drink drive
+driver drop dry dual due dump duplicate durable duration during dust duty dynamic
+each eager ear early earn earth ease easily east eastern easy eat echo economic economy
+edge edit edition editor education educational effect effective effectively efficiency efficient effort egg either elapse
+elect election electric electricity electronic element elevate eligible eliminate else elsewhere email embed embedded embedding
+emerge emergency emit emotion emotional emphasis employ employee employer employment empty enable enclose encode encoder
+encounter encourage encrypt encryption end endpoint enemy energy enforce enforcement engage engagement engine engineer engineering
+enhance enjoy enormous enough enqueue enroll enrollment ensure enter enterprise entire entirely entitle entitlement entity entrance
+entry enum enumerate enumeration envelope environment environmental episode equal equally equipment equity equivalent era error
+escalate escalation escape especially essay essential essentially establish establishment estate estimate etc evaluate evaluation even
+evening event eventual eventually ever every everybody everyone everything everywhere evidence evil exact exactly exam
+examine example exceed excellent except exception excess exchange excite exclude exclusion exclusive excuse exec execute
+execution executive executor exempt exercise exhaust exhibit exist existence existing exit expand expansion expect expectation
+expense expensive experience experiment experimental expert expiration expire expiry explain explanation explicit explicitly explode exploit
+explore export expose exposure express expression extend extension extensive extent external extra extract extraordinary extreme
+extremely eye
+face facility fact factor factory fail failover failure fair fairly faith fall false familiar
+family famous fan far farm fashion fast fat fatal father fault favor favour favorite favourite
+fear feature fee feed feedback feel feeling fellow female fetch few fiber fibre fiction field
+fight figure file filename filesystem fill filter final finally finance financial find finding fine finger
+finish fire firewall firm first fiscal fish fit fix fixed flag flat flavor flavour flexible
+flight float floor flow flush fly focus fold folder follow follower following food foot
+football for force forecast foreign forest forever forget fork form formal format former formula
+forth fortune forward found foundation founder fraction frame framework free freedom freeze frequency frequent
+frequently fresh friend friendly from front frontend frozen fruit fuel full fully fun function
+functional fund fundamental funding funny furniture further furthermore future
+gain gallery game gap garage garden gas gate gateway gather gauge gender general generally
+generate generation generator generic genre gentle genuine geographic geography gesture get getter gift girl
+give glad glance global glossary go goal god gold golf good govern governance government
+grab grade gradual gradually graduate grain grand grant granularity graph graphic great green greet
+grid ground group grow growth guarantee guard guess guest guidance guide guideline guitar gun
+guy
+habit hair half hall hand handle handler handshake hang happen happy hard hardly hardware
+harm hash hat hate have he head header heading headline health healthcheck healthy hear
+heart heat heavy height hello help helper hence her here hero hers herself hesitate
+hidden hide hierarchy high highlight highly hill him himself hint hip hire his historic
+historical history hit hold holder hole holiday home homepage honest honor honour hook hop hope
+horizon horizontal horse hospital host hostname hot hotel hour hourly house household housing how
+however huge human hundred hungry hunt hurry hurt husband hybrid hyperlink hyphen
+ice icon idea ideal identical identification identifier identify identity idle if ignore ill illegal
+illustrate image imagine immediate immediately immigrant immutable impact implement implementation implicit implicitly imply import importance
+important impose impossible impression improve improvement in inactive inbox incentive inch incident include inclusive income
+incoming incompatible incomplete inconsistent incorporate incorrect increase increasingly increment incremental incur indeed independence independent index
+indicate indication indicator individual indoor industrial industry inevitable infinite inflation influence inform informal information
+infrastructure ingest ingredient inherit inheritance initial initialize initially initiate initiative inject injection injury inline inner
+innocent innovation input inquiry insert inside insight inspect inspection install installation instance instant instantly instead
+institution instruction instrument insurance integer integral integrate integration integrity intelligence intend intense intent intention
+interact interaction interactive interest interesting interface interim intermediate internal international internet interpret interpretation interrupt interval
+intervention interview into introduce introduction invalid invalidate invent inventory invest investigate investigation investment investor invisible
+invitation invite invoice invoke involve iron irrelevant island isolate isolation issue issuer it item itself
+iterate iteration iterator
+job join joint journal journey judge judgment judgement jump junior jurisdiction just justice justify
+keep kernel key keyboard keyword kick kid kill kind king kit kitchen knee
+knock know knowledge known
+label lab laboratory lack ladder lady lake land landing landscape lane language laptop large
+largely last late latency later latest latitude latter laugh launch law lawyer lay layer layout
+lazy lead leader leadership leaf league lean learn lease least leave lecture left leg
+legacy legal legend lend length less lesson let letter level liability library licence license
+lie life lifecycle lifetime lift light like likely limit line linear link linked list
+listen listener literal literally literature little live living load loader loan local locale locate
+location lock log logger logic logical login logo logout long longitude look lookup loop
+loose lose loss lost lot loud love low lower lowercase loyal luck lunch
+machine mad magazine magic mail mailbox main mainly maintain maintainer maintenance major majority make
+maker male mall man manage management manager mandatory manifest manipulate manner manual manually manufacture manufacturer
+many map mapping margin mark market marketing marriage marry mask mass massive master match
+mate material math mathematics matrix matter maximize maximum may maybe mayor me meal mean
+meaning meanwhile measure measurement meat mechanism media median medical medium meet meeting member membership memo
+memory mention menu merchant mere merely merge mesh message metadata metal meter method methodology
+metric metro microphone middle middleware midnight might migrate migration mild mile military milk million millisecond
+mind mine minimal minimize minimum minister minor minority minute mirror miss mission mistake mix mobile
+mock mode model moderate modern modification modify module moment monday money monitor monitoring month monthly
+mood moon moral more moreover morning mortgage most mostly mother motion motor mount mountain mouse
+mouth move movement movie much multiple multiply municipal murder muscle museum music musical must mutable
+mutate mutation mutual my myself mystery
+nail name namespace narrative narrow nation national native natural naturally nature navigate navigation near nearby
+nearly neat necessarily necessary neck need negative negotiate negotiation neighbor neighbour neighborhood neither nest nested
+network neutral never nevertheless new newly news newsletter next nice night no nobody node noise
+nominal none nonetheless noon nor normal normalize normally north northern nose not note notebook nothing
+notice notification notify notion novel now nowhere nuance null nullable number numeric numerous nurse
+object objective obligation observation observe observer obtain obvious obviously occasion occasional occasionally occupation occupy occur
+occurrence ocean odd of off offer office officer official offline offset often oil okay
+old on once one ongoing online only onto open opening operate operation operational operator
+opinion opponent opportunity oppose opposite opt optimal optimistic optimization optimize option optional or oracle orange
+orbit order ordinary org organ organic organization organisation organize organise orientation origin original originally orphan
+other otherwise ought our ours ourselves out outage outbound outcome outdoor outer outgoing outline output
+outside outstanding oven over overall overdue overflow overhead overlap overlay overload overnight override overview overwrite
+owe own owner ownership
+pace pack package packet pad page pagination pain paint pair palette panel panic paper
+paragraph parallel parameter parent park parse parser part partial partially participant participate particular particularly partition
+partly partner partnership party pass passage passenger passion passive passphrase password past paste patch path
+patient pattern pause pay payable payee payer payload payment peace peak peer penalty pending people
+pepper per perceive percent percentage percentile perfect perfectly perform performance perhaps period periodic permanent permission
+permit persist persistence persistent person personal personally personnel perspective pet phase phone photo photograph phrase
+physical physically piano pick picture piece pin pipe pipeline pitch pivot pixel place placeholder
+plain plan plane planet platform play player playlist plaza pleasant please pleasure plenty plot plug
+plugin plural plus pocket podcast poem poet poetry point pointer police policy political politics
+poll pool poor pop popular popularity population port portal portfolio portion position positive possess possession
+possibility possible possibly post postal poster pot potential potentially pound pour poverty power powerful practical
+practice practise precede precedence precise precisely precision predicate predict prediction prefer preference prefix pregnant premium preparation
+prepare presence present presentation preserve president press pressure presumably pretty prevent previous previously price pricing
+pride primarily primary prime primitive prince principal principle print printer prior priority privacy private privilege
+probability probable probably probe problem procedure proceed process processor produce producer product production productive profession
+professional professor profile profit program programme programmer progress progressive prohibit project projection prominent promise promote
+promotion prompt proof propagate propagation proper properly property proportion proposal propose proprietary prospect protect protection
+protocol proud prove provide provider province provision proxy psychology public publication publicly publish publisher pull
+punch purchase pure purple purpose pursue push put puzzle
+qualification qualified qualify quality quantity quarter quarterly query question queue quick quickly quiet quietly quit
+quite quota quote
+race radical radio radius rail rain raise random range rank ranking rapid rapidly rare
+rarely rate rather ratio rating raw reach react reaction read reader readiness reading readonly ready
+real realistic reality realize realm really reason reasonable rebuild recall receipt receive receiver recent recently
+recipe recipient recognition recognize recommend recommendation reconcile record recover recovery recruit rectangle recursion recursive recycle
+red redeem redirect reduce reduction redundant refer reference referral reflect reflection reform refresh refund refuse
+regard regarding regardless region regional register registration registry regular regularly regulate regulation regulatory reject
+relate relation relationship relative relatively relax release relevant reliable relief relieve religion religious rely
+remain remainder remaining remark remarkable remember remind reminder remote removal remove render renew renewal rent
+repair repeat repeatable replace replacement replica replicate replication reply repo report reporter repository represent representation
+representative reputation request require requirement rescue research researcher reservation reserve reset reside residence resident resist
+resistance resolution resolve resolver resource respect respective respectively respond respondent response responsibility responsible rest restaurant
+restore restrict restriction result resume retail retain retention retire retirement retract retrieve retry return reuse
+reveal revenue reverse review reviewer revise revision revoke revolution reward rich rid ride right ring
+rise risk river road robot robust rock role roll rollback rollout roof room root
+rotate rotation rough round route router routine routing row royal rule ruler run runner
+runtime rural rush
+sad safe safely safety sake salary sale sales salt same sample sandbox satisfaction satisfy
+saturday save saving say scale scan scanner scenario scene schedule scheduler schema scheme scholar
+school science scientific scientist scope score scratch screen script scroll seal search season seat
+second secondary secret secretary section sector secure security see seed seek seem segment
+seize select selection selector self sell seller semantic semantics semester send sender senior sense
+sensitive sensor sentence separate separately separator sequence sequential serial serialize series serious seriously serve
+server service session set setting setup settle settlement several severe severity sex shade shadow
+shake shall shallow shape shard share shareholder sharp she sheet shelf shell shift ship
+shipment shipping shirt shock shoe shoot shop shopping short shortcut shortly shot should shoulder
+show shower shut shutdown sibling sick side sight sign signal signature significant significantly signup
+silence silent silver similar similarly simple simply simulate simulation since sing single singleton singular sink
+sir sister sit site situation size skill skin skip sky sleep slice slide slight
+slightly slot slow slowly small smart smell smile smoke smooth snapshot snow so social
+society socket soft software soil solar soldier sole solely solid solution solve some somebody someone
+something sometimes somewhat somewhere son song soon sophisticated sorry sort sound source south southern
+space span spare spatial speak speaker special specialist specific specifically specification specify speech speed spell
+spelling spend spin spirit spite split sponsor sport spot spread spring square squash stability stable
+stack staff stage stake stale stamp stand standard star start startup state statement static station
+statistic statistics status stay steady steal steel step stick still stock stone stop storage
+store story straight strange strategic strategy stream street strength stress stretch strict strictly strike
+string strip stroke strong strongly structural structure struggle stub student studio study stuff style
+subject submission submit subscribe subscriber subscription subsequent subsequently subset substance substantial substitute substring subtitle subtle
+subtract subtree succeed success successful successfully such sudden suddenly suffer sufficient suffix suggest suggestion suit
+suitable sum summarize summary summer sun sunday super supervisor supplement supplier supply support supporter suppose
+suppress sure surely surface surname surprise surround survey survive suspect suspend suspension sustain swap sweet
+swim switch symbol sync synchronize synchronous syntax system systematic
+tab table tablet tag tail take tale talent talk tall target task taste tax
+taxonomy tea teach teacher team tear technical technique technology telephone television tell temperature template temporal
+temporarily temporary tenant tend tendency tennis tension term terminal terminate termination test tester text textual
+than thank that the theater theatre their theirs them theme themselves then theory there therefore
+these they thick thin thing think third this thorough those though thought thousand thread
+threat threshold throttle through throughout throw thumbnail thursday thus ticket tie tier tight till time
+timeline timeout timer timestamp timezone tiny tip tire title to today together toggle token tolerance
+tomorrow tone tonight too tool toolbar top topic total totally touch tough tour toward towards
+tower town toy trace track tracker trade tradition traditional traffic trail train trainer training transact
+transaction transcript transfer transform transformation transit transition translate translation transmission transmit transparent transport trap
+travel treat treatment tree trend trial trigger trim trip trouble truck true truly trunk
+trust truth try tuesday tune tuple turn tutorial twice twin type typical typically
+ugly ultimate ultimately unable uncle under underlying understand undo unfortunately uniform union unique unit
+unite unity universal universe university unknown unless unlike unlikely unlimited unmarshal unset unsubscribe until unusual
+up upcoming update upgrade upload upon upper uppercase upsert upstream urban urge urgent us usage
+use useful user username usual usually utility utilize
+vacation valid validate validator validity valley valuable value variable variance variant variation variety various vary
+vast vector vehicle vendor venture venue verb verbose verdict verification verify version versus vertical very
+vessel veteran via victim victory video view viewer village violate violation violence virtual virtually
+visibility visible vision visit visitor visual vital voice void volume voluntary volunteer vote voter voucher
+vs vulnerability vulnerable
+wage wait wake walk wall wallet want war warehouse warm warn warning wash waste
+watch water wave way we weak weakness wealth weapon wear weather web webhook website
+wedding wednesday week weekday weekend weekly weigh weight weird welcome well west western wet what
+whatever wheel when whenever where whereas wherever whether which while white whitelist who whoever whole
+whom whose why wide widely widget width wife wild will willing win wind window wine
+wing winner winter wire wise wish with withdraw withdrawal within without witness woman wonder wonderful
+wood word work worker workflow workload workspace world worry worse worst worth would wrap wrapper
+write writer wrong
+yard yeah year yearly yellow yes yesterday yet yield you young your yours yourself yourselves
+youth
+zero zip zone zoom
+
+ain't aren't can't couldn't didn't doesn't don't hadn't hasn't haven't he's i'd i'll i'm i've isn't it's
+let's mustn't shan't she's shouldn't that's there's they'd they'll they're they've wasn't we'd we'll we're we've
+weren't what's who's won't wouldn't you'd you'll you're you've
+
+am an are be been being did does done gone had has having is was went were
+better best worse worst more most less least
+bought brought built caught chose chosen came drew drawn drove driven fed felt fought found fled flew flown forgot
+forgotten froze frozen gave given got gotten grew grown held hid hidden kept knew known laid led left lent
+lost made meant met paid ran rang rose risen said sat saw seen sent set shook shot showed shown
+sold sought spent spoke spoken stood stole stolen struck swore sworn taught thought threw thrown told took taken
+understood woke woken won wore worn wrote written
+children feet geese indices matrices men mice people teeth vertices women analyses bases crises hypotheses theses
+criteria phenomena appendices schemata
+
+alpha april august beta december february friday gamma january july june march monday may november october
+saturday september sunday thursday tuesday wednesday
+zero one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen seventeen
+eighteen nineteen twenty thirty forty fifty sixty seventy eighty ninety hundred thousand million billion trillion
+first second third fourth fifth sixth seventh eighth ninth tenth half quarter double triple
+
+acl ack aes ai aip amd api app ascii async auth authn authz avro aws backend bcc bool boolean
+cc ccpa cdn cidr cli cmd cors cpu crc crud csrf css csv ctx cvv dag db dcl ddl
+dedup dest dev devops dir dml dns docker dom dpi dst dto ecdsa eg enum env eof epoch
+etag etl faq fifo fqdn ftp gcp gcs gdpr gid gif git github gitlab gps gpu grpc gui
+guid gzip hmac html http https iam iat iban ie iana ics ide idp ids imap int ios ip ipv
+iso isbn issn jpeg jpg json jsonb jwt kb kms kpi ksuid kubernetes lan ldap lifo lru md
+mfa mime ml mms mqtt msg mtls mx nan noop nosql nullable oauth ocr oidc ok oltp otp
+param params pb pdf pem pgp php pid pii pkcs png pojo pos postgres pptx prev proto protobuf
+qps qr ram rbac rdf regex regexp repo rest rfc rgb rgba rpc rsa rss rtt saml sas sdk
+sha sku sla slo sms smtp soap sql src ssh ssl sso stderr stdin stdout struct svg tcp
+tld tls todo fixme tos ttl tz udp uid ui uint uri url urn usb usd utc utf uuid
+vat vm vpc vpn wal wasm webp wifi www xml xsrf yaml yml zipcode
+admin amount async backfill bitfield blocklist allowlist changeset checkbox codebase config cron dataset datastore deserialize
+denylist dropdown email filepath fileset hashtag hostname inbox keepalive keystore lifecycle login logout lookup metadata
+microservice multipart namespace nonce offline online pagination passcode pathname plaintext ciphertext postcode prefetch presigned
+pubsub readme realtime redact refcount runbook screenshot serializer sitemap smartphone stacktrace subdomain subfolder subnet subquery
+subtotal superuser textbox timeline toolchain touchpoint typeahead unary unicode uptime upvote downvote username userspace viewport
+webpage whitespace wildcard workflow writable readable zipcode
+abbreviation absorb accidental acronym adjective adverb affine ambiguous ambiguity anomaly arithmetic architecture
+asterisk bitwise bootstrap broken bubble bump callee caret casing camel chronological cipher colon comma comic
+concise consecutive contiguous convenience cryptography cumulative decimal degenerate delimit delta dummy
+duplicate elliptic emoji encapsulate endian entropy equation exponent exponential fake fragment
+garbage gray grey hack handoff heap heuristic hex histogram hyphen idempotent idempotency immutable
+indefinite indent indirect infer infinity inhibit inode instantiate intercept invariant inverse invocation irreversible
+leak malformed mangle mantissa minus modulo modulus monotonic mutex naive nonce notation null octet omit opaque
+operand parenthesis parentheses permutation plaintext polynomial populate precedence prologue prune pseudo quotient
+racy recurse redact redundancy remainder resumption revocation sanity scalar scavenge semaphore semicolon sentinel
+shrink simplify simultaneous slash spawn spill splice spurious suite sweep symbolic synthetic tamper tidy traversal
+traverse trie trivial truncate typedef underscore unify verse wakeup whence whitespace
+
+abs addr alloc alt arg args asc attr buf cap cert cmp cond conn const cur
+dep deps desc dup elem eof err eval exp expr ext func gen idx impl inc init int len lib
+max mem meta min mod multi nil non num obj orig pkg pre ptr ref reg req res ret
+sec seq sig spec src srv stat std stmt str sub sync tmp txt val var
+
+android java javascript kotlin linux mac macos python ruby rust swift typescript unix windows posix golang
+fixed sfixed sint oneof oneofs proto protos rpcs enums grpc
+annotate overridden hidden unspecified
+memoir novel
+arena builtin defer finalize info marshal microsecond nanosecond net peek preempt sanitize swept symlink unification variadic xor
+bar baz foo qux
+`
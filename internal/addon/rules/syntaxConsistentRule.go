@@ -4,6 +4,7 @@ import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
 	"github.com/tyhal/protolint/linter/report"
+	"github.com/tyhal/protolint/linter/rule"
 	"github.com/tyhal/protolint/linter/visitor"
 )
 
@@ -39,6 +40,21 @@ func (r SyntaxConsistentRule) IsOfficial() bool {
 	return false
 }
 
+// Doc returns the long-form documentation of this rule.
+func (r SyntaxConsistentRule) Doc() rule.Doc {
+	return rule.Doc{
+		Rationale:   "Mixing proto2 and proto3 files makes the semantics of the fields differ from file to file.",
+		BadExample:  `syntax = "proto2";`,
+		GoodExample: `syntax = "proto3";`,
+		Options: []rule.OptionDoc{
+			{
+				Name:        "version",
+				Description: `The syntax version, proto2, proto3 or an edition like "2023". The default is "proto3".`,
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r SyntaxConsistentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &syntaxConsistentVisitor{
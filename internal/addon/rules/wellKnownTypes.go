@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"strings"
+)
+
+// wrapperTypes are the wrapper types of google/protobuf/wrappers.proto keyed by the scalar types.
+var wrapperTypes = map[string]string{
+	"double":   "google.protobuf.DoubleValue",
+	"float":    "google.protobuf.FloatValue",
+	"int64":    "google.protobuf.Int64Value",
+	"sint64":   "google.protobuf.Int64Value",
+	"sfixed64": "google.protobuf.Int64Value",
+	"uint64":   "google.protobuf.UInt64Value",
+	"fixed64":  "google.protobuf.UInt64Value",
+	"int32":    "google.protobuf.Int32Value",
+	"sint32":   "google.protobuf.Int32Value",
+	"sfixed32": "google.protobuf.Int32Value",
+	"uint32":   "google.protobuf.UInt32Value",
+	"fixed32":  "google.protobuf.UInt32Value",
+	"bool":     "google.protobuf.BoolValue",
+	"string":   "google.protobuf.StringValue",
+	"bytes":    "google.protobuf.BytesValue",
+}
+
+// isNumericType decides whether or not the type is a scalar number type.
+func isNumericType(typ string) bool {
+	_, ok := wrapperTypes[typ]
+	return ok && typ != "bool" && typ != "string" && typ != "bytes"
+}
+
+// hasNameSuffix decides whether or not the snake_case name ends with any of the suffixes.
+// The suffix "_at" matches "created_at" and "at", but not "format".
+func hasNameSuffix(name string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) || name == strings.TrimPrefix(suffix, "_") {
+			return true
+		}
+	}
+	return false
+}
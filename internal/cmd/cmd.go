@@ -5,6 +5,9 @@ import (
 	"io"
 	"strings"
 
+	"github.com/tyhal/protolint/internal/cmd/subcmds/config"
+	"github.com/tyhal/protolint/internal/cmd/subcmds/explain"
+	"github.com/tyhal/protolint/internal/cmd/subcmds/initialize"
 	"github.com/tyhal/protolint/internal/cmd/subcmds/lint"
 	"github.com/tyhal/protolint/internal/cmd/subcmds/list"
 	"github.com/tyhal/protolint/internal/osutil"
@@ -20,16 +23,40 @@ Usage:
 The commands are:
 	lint     lint protocol buffer files
 	list     list all current lint rules being used
+	explain  explain a lint rule with its rationale and examples
+	init     scaffold a config file from the existing protocol buffer files
+	config   validate, print or generate the schema of the config file
 	version  print protolint version
 `
+
+	configHelp = `
+Protocol Buffer Linter Config Command.
+
+Usage:
+	protolint config <command> [arguments]
+
+The commands are:
+	validate [target paths]  validate the config file. The target paths are used to find dead ignore entries
+	print [file]             print the effective config, optionally resolved for the file
+	schema                   print the JSON Schema of the config file
+`
 )
 
 const (
 	subCmdLint    = "lint"
 	subCmdList    = "list"
+	subCmdExplain = "explain"
+	subCmdInit    = "init"
+	subCmdConfig  = "config"
 	subCmdVersion = "version"
 )
 
+const (
+	subCmdConfigValidate = "validate"
+	subCmdConfigPrint    = "print"
+	subCmdConfigSchema   = "schema"
+)
+
 var (
 	version  = "master"
 	revision = "latest"
@@ -63,7 +90,13 @@ func doSub(
 	case subCmdLint:
 		return doLint(args[1:], stdout, stderr)
 	case subCmdList:
-		return doList(stdout, stderr)
+		return doList(args[1:], stdout, stderr)
+	case subCmdExplain:
+		return doExplain(args[1:], stdout, stderr)
+	case subCmdInit:
+		return doInit(args[1:], stdout, stderr)
+	case subCmdConfig:
+		return doConfig(args[1:], stdout, stderr)
 	case subCmdVersion:
 		return doVersion(stdout)
 	default:
@@ -111,16 +144,78 @@ func doLint(
 }
 
 func doList(
+	args []string,
 	stdout io.Writer,
 	stderr io.Writer,
 ) osutil.ExitCode {
+	flags, err := list.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
 	subCmd := list.NewCmdList(
+		flags,
 		stdout,
 		stderr,
 	)
 	return subCmd.Run()
 }
 
+func doExplain(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	if len(args) != 1 {
+		_, _ = fmt.Fprintln(stderr, "protolint explain requires a rule ID. Run `protolint list` to see all available rules.")
+		return osutil.ExitInternalFailure
+	}
+	return explain.NewCmdExplain(args[0], stdout, stderr).Run()
+}
+
+func doInit(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	flags, err := initialize.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprint(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return initialize.NewCmdInit(flags, stdout, stderr).Run()
+}
+
+func doConfig(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	if len(args) < 1 {
+		_, _ = fmt.Fprint(stderr, configHelp)
+		return osutil.ExitInternalFailure
+	}
+
+	flags, err := config.NewFlags(args[0], args[1:])
+	if err != nil {
+		_, _ = fmt.Fprint(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	switch args[0] {
+	case subCmdConfigValidate:
+		return config.NewCmdConfigValidate(flags, stdout, stderr).Run()
+	case subCmdConfigPrint:
+		return config.NewCmdConfigPrint(flags, stdout, stderr).Run()
+	case subCmdConfigSchema:
+		return config.NewCmdConfigSchema(flags, stdout, stderr).Run()
+	default:
+		_, _ = fmt.Fprint(stderr, configHelp)
+		return osutil.ExitInternalFailure
+	}
+}
+
 func doVersion(
 	stdout io.Writer,
 ) osutil.ExitCode {
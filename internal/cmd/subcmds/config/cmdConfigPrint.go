@@ -0,0 +1,113 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/osutil"
+)
+
+// CmdConfigPrint is a command to print the effective config.
+type CmdConfigPrint struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdConfigPrint creates a new CmdConfigPrint.
+func NewCmdConfigPrint(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdConfigPrint {
+	return &CmdConfigPrint{
+		stdout: stdout,
+		stderr: stderr,
+		flags:  flags,
+	}
+}
+
+// Run prints the effective config.
+func (c *CmdConfigPrint) Run() osutil.ExitCode {
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdConfigPrint) run() error {
+	externalConfig, err := config.GetExternalConfig(c.flags.ConfigPath, c.flags.ConfigDirPath)
+	if err != nil {
+		return err
+	}
+
+	var displayPath string
+	switch args := c.flags.Args(); len(args) {
+	case 0:
+	case 1:
+		protoSet, err := file.NewProtoSet(args)
+		if err != nil {
+			return err
+		}
+		if len(protoSet.ProtoFiles()) != 1 {
+			return fmt.Errorf("%s is not a protocol buffer file", args[0])
+		}
+		displayPath = protoSet.ProtoFiles()[0].DisplayPath()
+	default:
+		return fmt.Errorf("protolint config print accepts at most one file")
+	}
+
+	effective, err := effectiveConfig(externalConfig, displayPath, c.flags)
+	if err != nil {
+		return err
+	}
+	data, err := config.MarshalWithoutZero(effective)
+	if err != nil {
+		return err
+	}
+
+	if 0 < len(displayPath) {
+		_, err = fmt.Fprintf(c.stdout, "# The effective config for %s\n", displayPath)
+	} else {
+		_, err = fmt.Fprintln(c.stdout, "# The effective config")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout.Write(data)
+	return err
+}
+
+// effectiveConfig resolves the config, so that the rules are listed explicitly.
+// If the file is specified, the overrides, the ignores and the excludes are applied for it.
+func effectiveConfig(
+	externalConfig config.ExternalConfig,
+	displayPath string,
+	flags Flags,
+) (config.ExternalConfig, error) {
+	rs, err := subcmds.NewEnabledRules(externalConfig, displayPath, false, flags.Verbose, flags.Plugins, nil)
+	if err != nil {
+		return config.ExternalConfig{}, err
+	}
+
+	effective := externalConfig
+	if 0 < len(displayPath) {
+		effective, err = externalConfig.Resolve(displayPath)
+		if err != nil {
+			return config.ExternalConfig{}, err
+		}
+		effective.Lint.Ignores = nil
+		effective.Lint.Files.Exclude = nil
+		effective.Lint.Directories.Exclude = nil
+	}
+	effective.Lint.Rules = config.Rules{
+		NoDefault: true,
+		Add:       rs.IDs(),
+	}
+	return effective, nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/osutil"
+)
+
+// CmdConfigSchema is a command to print the JSON Schema of the config file.
+type CmdConfigSchema struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdConfigSchema creates a new CmdConfigSchema.
+func NewCmdConfigSchema(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdConfigSchema {
+	return &CmdConfigSchema{
+		stdout: stdout,
+		stderr: stderr,
+		flags:  flags,
+	}
+}
+
+// Run prints the JSON Schema.
+func (c *CmdConfigSchema) Run() osutil.ExitCode {
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdConfigSchema) run() error {
+	if 0 < len(c.flags.Args()) {
+		return fmt.Errorf("protolint config schema accepts no arguments")
+	}
+
+	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, c.flags.Verbose, nil)
+	if err != nil {
+		return err
+	}
+	data, err := GenerateSchema(rs)
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout.Write(data)
+	return err
+}
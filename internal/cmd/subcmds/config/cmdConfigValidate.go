@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/osutil"
+)
+
+// CmdConfigValidate is a command to validate the config file.
+type CmdConfigValidate struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdConfigValidate creates a new CmdConfigValidate.
+func NewCmdConfigValidate(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdConfigValidate {
+	return &CmdConfigValidate{
+		stdout: stdout,
+		stderr: stderr,
+		flags:  flags,
+	}
+}
+
+// Run validates the config file.
+func (c *CmdConfigValidate) Run() osutil.ExitCode {
+	errs, err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	for _, e := range errs {
+		_, _ = fmt.Fprintln(c.stderr, e)
+	}
+	if 0 < len(errs) {
+		return osutil.ExitLintFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdConfigValidate) run() ([]config.ValidationError, error) {
+	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, c.flags.Verbose, c.flags.Plugins)
+	if err != nil {
+		return nil, err
+	}
+
+	displayPaths, err := c.targetDisplayPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	filePath, errs, err := config.ValidateExternalConfig(
+		c.flags.ConfigPath,
+		c.flags.ConfigDirPath,
+		rs.IDs(),
+		rs.OptionDocs(),
+		displayPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) == 0 {
+		_, _ = fmt.Fprintf(c.stdout, "%s is valid.\n", filePath)
+	}
+	return errs, nil
+}
+
+// targetDisplayPaths collects the target files to find dead ignore entries.
+// It returns no paths if the targets are not specified.
+func (c *CmdConfigValidate) targetDisplayPaths() ([]string, error) {
+	if len(c.flags.Args()) == 0 {
+		return nil, nil
+	}
+
+	protoSet, err := file.NewProtoSet(c.flags.Args())
+	if err != nil {
+		return nil, err
+	}
+	var displayPaths []string
+	for _, f := range protoSet.ProtoFiles() {
+		displayPaths = append(displayPaths, f.DisplayPath())
+	}
+	return displayPaths, nil
+}
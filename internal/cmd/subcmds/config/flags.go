@@ -0,0 +1,62 @@
+package config
+
+import (
+	"flag"
+
+	"github.com/tyhal/protolint/internal/addon/plugin/shared"
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+)
+
+// Flags represents a set of config flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	ConfigPath    string
+	ConfigDirPath string
+	Verbose       bool
+	Plugins       []shared.RuleSet
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	name string,
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet(name, flag.ExitOnError),
+	}
+	var pf subcmds.PluginFlag
+
+	f.StringVar(
+		&f.ConfigPath,
+		"config_path",
+		"",
+		"path/to/protolint.yaml. Note that if both are set, config_dir_path is ignored.",
+	)
+	f.StringVar(
+		&f.ConfigDirPath,
+		"config_dir_path",
+		"",
+		"path/to/the_directory_including_protolint.yaml",
+	)
+	f.Var(
+		&pf,
+		"plugin",
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'`,
+	)
+	f.BoolVar(
+		&f.Verbose,
+		"v",
+		false,
+		"verbose output that includes parsing process details",
+	)
+
+	_ = f.Parse(args)
+
+	plugins, err := pf.BuildPlugins(f.Verbose)
+	if err != nil {
+		return Flags{}, err
+	}
+	f.Plugins = plugins
+	return f, nil
+}
@@ -0,0 +1,249 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tyhal/protolint/internal/linter/config"
+	internalrule "github.com/tyhal/protolint/internal/linter/rule"
+)
+
+const (
+	schemaDraft = "http://json-schema.org/draft-07/schema#"
+	schemaTitle = "protolint config"
+)
+
+// jsonSchema represents a subset of JSON Schema draft-07.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	Definitions          map[string]*jsonSchema `json:"definitions,omitempty"`
+}
+
+// descriptions are the descriptions of the config elements other than the rules options.
+var descriptions = map[string]string{
+	"lint":                            "Lint directives.",
+	"lint.ignores":                    "Linter files to ignore.",
+	"lint.ignores[].id":               "The rule ID to ignore.",
+	"lint.ignores[].files":            `Glob patterns of the files to ignore the rule. "**" matches zero or more directories and a "!" prefix re-includes the file.`,
+	"lint.files":                      "Linter files to walk.",
+	"lint.files.exclude":              `Glob patterns of the files to exclude. A "!" prefix re-includes the file.`,
+	"lint.files.respect_ignore_files": "Determines whether or not to exclude the files listed in .gitignore and .protolintignore.",
+	"lint.directories":                "Linter directories to walk.",
+	"lint.directories.exclude":        `Glob patterns of the directories to exclude. A "!" prefix re-includes the directory.`,
+	"lint.rules":                      "Linter rules. Run `protolint list` to see all available rules.",
+	"lint.rules.no_default":           "Determines whether or not to include the default set of linters.",
+	"lint.rules.all_default":          "Set the default to all linters. This option works the other way around as no_default does.",
+	"lint.rules.add":                  "The specific linters to add.",
+	"lint.rules.remove":               "The specific linters to remove.",
+	"lint.rules_option":               "Linter rules option.",
+	"lint.overrides":                  "Linter overrides for the specific files, applied in order.",
+	"lint.overrides[].files":          "Glob patterns of the files to apply the override.",
+	"lint.overrides[].rules":          "The specific linters to add or remove for the files.",
+	"lint.overrides[].rules.add":      "The specific linters to add.",
+	"lint.overrides[].rules.remove":   "The specific linters to remove.",
+	"lint.overrides[].rules_option":   "The rules options to override for the files. The options not written here are kept.",
+}
+
+var newlineEnum = []string{"\n", "\r", "\r\n"}
+
+// GenerateSchema generates the JSON Schema of the config file from the config structs.
+// The descriptions of the rules options come from the purposes of the rules.
+func GenerateSchema(
+	rules internalrule.Rules,
+) ([]byte, error) {
+	g := schemaGenerator{
+		purposes: make(map[string]string),
+	}
+	for _, r := range rules {
+		g.ruleIDs = append(g.ruleIDs, r.ID())
+		g.purposes[r.ID()] = r.Purpose()
+	}
+	sort.Strings(g.ruleIDs)
+
+	root := g.generate(reflect.TypeOf(config.ExternalConfig{}), "")
+	root.Schema = schemaDraft
+	root.Title = schemaTitle
+	root.Definitions = map[string]*jsonSchema{
+		"ruleID": {
+			Description: "The rule ID. The IDs of the rules provided by plugins are also available.",
+			AnyOf: []*jsonSchema{
+				{
+					Type: "string",
+					Enum: g.ruleIDs,
+				},
+				{
+					Type:    "string",
+					Pattern: "^[A-Z0-9_]+$",
+				},
+			},
+		},
+		"rulesOption": g.generateRulesOption(),
+	}
+
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type schemaGenerator struct {
+	ruleIDs  []string
+	purposes map[string]string
+}
+
+func (g schemaGenerator) generate(
+	t reflect.Type,
+	path string,
+) *jsonSchema {
+	switch t {
+	case reflect.TypeOf(config.RulesOption{}), reflect.TypeOf(config.OverrideRulesOption{}):
+		return &jsonSchema{
+			Description: descriptions[path],
+			Ref:         "#/definitions/rulesOption",
+		}
+	case reflect.TypeOf(config.IndentOption{}):
+		return &jsonSchema{
+			Type: "object",
+			Properties: map[string]*jsonSchema{
+				"style": {
+					Description: "Available styles are 4(4-spaces), 2(2-spaces) or tab.",
+					Type:        "string",
+					Enum:        []string{"tab", "4", "2"},
+				},
+				"newline": {
+					Description: `Available newlines are "\n", "\r", or "\r\n".`,
+					Type:        "string",
+					Enum:        newlineEnum,
+				},
+			},
+			AdditionalProperties: false,
+		}
+	case reflect.TypeOf(config.ImportsSortedOption{}):
+		return &jsonSchema{
+			Type: "object",
+			Properties: map[string]*jsonSchema{
+				"newline": {
+					Description: `Available newlines are "\n", "\r", or "\r\n".`,
+					Type:        "string",
+					Enum:        newlineEnum,
+				},
+			},
+			AdditionalProperties: false,
+		}
+	case reflect.TypeOf(config.SyntaxConsistentOption{}):
+		return &jsonSchema{
+			Type: "object",
+			Properties: map[string]*jsonSchema{
+				"version": {
+					Description: `Available versions are proto2, proto3 or an edition like "2023".`,
+					Type:        "string",
+					Pattern:     config.SyntaxVersionPattern,
+				},
+			},
+			AdditionalProperties: false,
+		}
+	}
+
+	if isRuleIDPath(path) {
+		return &jsonSchema{
+			Description: descriptions[path],
+			Ref:         "#/definitions/ruleID",
+		}
+	}
+
+	s := &jsonSchema{
+		Description: descriptions[path],
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		s.Type = "object"
+		s.Properties = make(map[string]*jsonSchema)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				// yaml.v2 doesn't decode the unexported fields.
+				continue
+			}
+			key := config.YAMLKey(field)
+			s.Properties[key] = g.generate(field.Type, joinPath(path, key))
+		}
+		s.AdditionalProperties = false
+	case reflect.Slice:
+		s.Type = "array"
+		if isRuleIDPath(path + "[]") {
+			s.Items = &jsonSchema{Ref: "#/definitions/ruleID"}
+		} else {
+			s.Items = g.generate(t.Elem(), path+"[]")
+		}
+	case reflect.Map:
+		s.Type = "object"
+		s.AdditionalProperties = g.generate(t.Elem(), path+"{}")
+	case reflect.String:
+		s.Type = "string"
+	case reflect.Bool:
+		s.Type = "boolean"
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		s.Type = "integer"
+	default:
+		panic(fmt.Sprintf("unsupported type %s in the config", t))
+	}
+	return s
+}
+
+func (g schemaGenerator) generateRulesOption() *jsonSchema {
+	t := reflect.TypeOf(config.RulesOption{})
+	s := &jsonSchema{
+		Description: descriptions["lint.rules_option"],
+		Type:        "object",
+		Properties:  make(map[string]*jsonSchema),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := config.YAMLKey(field)
+		option := g.generate(field.Type, "")
+		// The option key is the lower case of the rule ID.
+		ruleID := strings.ToUpper(key)
+		if purpose, ok := g.purposes[ruleID]; ok {
+			option.Description = fmt.Sprintf("%s rule option. %s", ruleID, purpose)
+		}
+		s.Properties[key] = option
+	}
+	s.AdditionalProperties = false
+	return s
+}
+
+func isRuleIDPath(path string) bool {
+	switch path {
+	case "lint.ignores[].id",
+		"lint.rules.add[]",
+		"lint.rules.remove[]",
+		"lint.overrides[].rules.add[]",
+		"lint.overrides[].rules.remove[]":
+		return true
+	}
+	return false
+}
+
+func joinPath(
+	path string,
+	key string,
+) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
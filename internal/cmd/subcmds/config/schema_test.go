@@ -0,0 +1,40 @@
+package config_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/cmd/subcmds/config"
+	linterconfig "github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+func TestGenerateSchema(t *testing.T) {
+	rs, err := subcmds.NewAllRules(linterconfig.RulesOption{}, false, false, nil)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	got, err := config.GenerateSchema(rs)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if !json.Valid(got) {
+		t.Errorf("got invalid JSON %s", got)
+		return
+	}
+
+	want, err := ioutil.ReadFile(setting_test.ProjectPath("_schema", "protolint.schema.json"))
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("_schema/protolint.schema.json is out of date. Run `make dev/build/schema` to regenerate it.")
+	}
+}
@@ -0,0 +1,120 @@
+package explain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/linter/rule"
+)
+
+// CmdExplain is a command to explain a rule.
+type CmdExplain struct {
+	stdout io.Writer
+	stderr io.Writer
+	ruleID string
+}
+
+// NewCmdExplain creates a new CmdExplain.
+func NewCmdExplain(
+	ruleID string,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdExplain {
+	return &CmdExplain{
+		stdout: stdout,
+		stderr: stderr,
+		ruleID: ruleID,
+	}
+}
+
+// Run explains the rule.
+func (c *CmdExplain) Run() osutil.ExitCode {
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdExplain) run() error {
+	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rs {
+		if r.ID() == c.ruleID {
+			_, err = io.WriteString(c.stdout, explain(r))
+			return err
+		}
+	}
+	return fmt.Errorf("unknown rule ID %q. Run `protolint list` to see all available rules", c.ruleID)
+}
+
+func explain(r rule.Rule) string {
+	var b strings.Builder
+	p := func(format string, a ...interface{}) {
+		_, _ = fmt.Fprintf(&b, format+"\n", a...)
+	}
+	yesNo := func(yes bool) string {
+		if yes {
+			return "yes"
+		}
+		return "no"
+	}
+
+	var fixable bool
+	if f, ok := r.(rule.HasIsFixable); ok {
+		fixable = f.IsFixable()
+	}
+
+	p("%s: %s", r.ID(), r.Purpose())
+	p("")
+	p("Official: %s", yesNo(r.IsOfficial()))
+	p("Fixable:  %s", yesNo(fixable))
+
+	d, ok := r.(rule.HasDoc)
+	if !ok {
+		return b.String()
+	}
+	doc := d.Doc()
+	if 0 < len(doc.StyleGuideURL) {
+		p("Style guide: %s", doc.StyleGuideURL)
+	}
+	if 0 < len(doc.Rationale) {
+		p("")
+		p("Rationale:")
+		p("%s", indent(doc.Rationale))
+	}
+	if 0 < len(doc.BadExample) {
+		p("")
+		p("Bad:")
+		p("%s", indent(doc.BadExample))
+	}
+	if 0 < len(doc.GoodExample) {
+		p("")
+		p("Good:")
+		p("%s", indent(doc.GoodExample))
+	}
+	if 0 < len(doc.Options) {
+		p("")
+		p("Options (rules_option.%s):", strings.ToLower(r.ID()))
+		for _, option := range doc.Options {
+			p("  %s: %s", option.Name, option.Description)
+		}
+	}
+	return b.String()
+}
+
+func indent(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = "  " + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,66 @@
+package explain_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds/explain"
+	"github.com/tyhal/protolint/internal/osutil"
+)
+
+func TestCmdExplain_Run(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		ruleID       string
+		wantExitCode osutil.ExitCode
+		wantStdout   []string
+		wantStderr   string
+	}{
+		{
+			name:         "explain a fixable rule",
+			ruleID:       "INDENT",
+			wantExitCode: osutil.ExitSuccess,
+			wantStdout: []string{
+				"INDENT: Enforces a consistent indentation style.\n",
+				"Fixable:  yes\n",
+				"Style guide: https://developers.google.com/protocol-buffers/docs/style#standard-file-formatting\n",
+				"Rationale:\n",
+				"Options (rules_option.indent):\n  style: ",
+			},
+		},
+		{
+			name:         "explain an unofficial rule",
+			ruleID:       "SYNTAX_CONSISTENT",
+			wantExitCode: osutil.ExitSuccess,
+			wantStdout: []string{
+				"Official: no\n",
+				"Fixable:  no\n",
+				"Bad:\n  syntax = \"proto2\";\n",
+			},
+		},
+		{
+			name:         "unknown rule",
+			ruleID:       "UNKNOWN",
+			wantExitCode: osutil.ExitInternalFailure,
+			wantStderr:   `unknown rule ID "UNKNOWN"`,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			got := explain.NewCmdExplain(test.ruleID, &stdout, &stderr).Run()
+			if got != test.wantExitCode {
+				t.Errorf("got %v, but want %v", got, test.wantExitCode)
+			}
+			for _, want := range test.wantStdout {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("got %q, but want to contain %q", stdout.String(), want)
+				}
+			}
+			if !strings.Contains(stderr.String(), test.wantStderr) {
+				t.Errorf("got %q, but want to contain %q", stderr.String(), test.wantStderr)
+			}
+		})
+	}
+}
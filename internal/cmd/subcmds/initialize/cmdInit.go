@@ -0,0 +1,265 @@
+package initialize
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/linter/rule"
+)
+
+// CmdInit is a command to scaffold a config file from the existing files.
+type CmdInit struct {
+	l      *linter.Linter
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdInit creates a new CmdInit.
+func NewCmdInit(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdInit {
+	return &CmdInit{
+		l:      linter.NewLinter(),
+		stdout: stdout,
+		stderr: stderr,
+		flags:  flags,
+	}
+}
+
+// Run writes the config file.
+func (c *CmdInit) Run() osutil.ExitCode {
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdInit) run() error {
+	toStdout := c.flags.OutputPath == "-"
+	if !toStdout && !c.flags.Force {
+		if _, err := os.Stat(c.flags.OutputPath); err == nil {
+			return fmt.Errorf("%s already exists. Use -force to overwrite it", c.flags.OutputPath)
+		}
+	}
+
+	// The files are collected as lint does, so that the excluded and ignored files don't make the rules fail.
+	externalConfig, err := config.GetExternalConfig(c.flags.ConfigPath, c.flags.ConfigDirPath)
+	if err != nil {
+		return err
+	}
+	protoSet, err := file.NewProtoSet(
+		c.flags.FilePaths,
+		file.WithSkipDir(externalConfig.ShouldSkipDir),
+		file.WithSkipFile(externalConfig.ShouldSkipFile),
+		file.WithIgnoreFiles(externalConfig.IgnoreFileNames()...),
+		file.WithIgnoreRootDir(externalConfig.DirPath()),
+	)
+	if err != nil {
+		return err
+	}
+	protoFiles := protoSet.ProtoFiles()
+
+	conv, err := detectConventions(protoFiles)
+	if err != nil {
+		return err
+	}
+	option := conv.rulesOption()
+
+	rs, err := subcmds.NewAllRules(option, false, c.flags.Verbose, nil)
+	if err != nil {
+		return err
+	}
+	stats, err := c.countFailures(protoFiles, rs)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	writeConfig(&buf, c.flags, externalConfig.Lint, conv, rs, stats)
+
+	if toStdout {
+		_, err = c.stdout.Write(buf.Bytes())
+		return err
+	}
+	err = ioutil.WriteFile(c.flags.OutputPath, buf.Bytes(), 0644)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.stdout, "Wrote %s for %d files.\n", c.flags.OutputPath, len(protoFiles))
+	return err
+}
+
+// failureStat represents the failures of a rule.
+type failureStat struct {
+	failures int
+	files    int
+}
+
+func (c *CmdInit) countFailures(
+	protoFiles []file.ProtoFile,
+	rs []rule.Rule,
+) (map[string]failureStat, error) {
+	hasApplies := make([]rule.HasApply, len(rs))
+	for i, r := range rs {
+		hasApplies[i] = r
+	}
+
+	stats := make(map[string]failureStat)
+	for _, f := range protoFiles {
+		proto, err := f.Parse(c.flags.Verbose)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", f.DisplayPath(), err)
+		}
+
+		failures, err := c.l.Run(proto, hasApplies)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := make(map[string]bool)
+		for _, failure := range failures {
+			stat := stats[failure.RuleID()]
+			stat.failures++
+			if !seen[failure.RuleID()] {
+				seen[failure.RuleID()] = true
+				stat.files++
+			}
+			stats[failure.RuleID()] = stat
+		}
+	}
+	return stats, nil
+}
+
+func (c conventions) rulesOption() config.RulesOption {
+	var option config.RulesOption
+	option.Indent.Style = c.style
+	option.Indent.Newline = c.newline
+	option.ImportsSorted.Newline = c.newline
+	option.MaxLineLength.MaxChars = c.maxLineLength
+	return option
+}
+
+func writeConfig(
+	w io.Writer,
+	flags Flags,
+	lint config.Lint,
+	conv conventions,
+	rs []rule.Rule,
+	stats map[string]failureStat,
+) {
+	var add, remove []string
+	for _, r := range rs {
+		if flags.RemoveThreshold <= stats[r.ID()].failures {
+			remove = append(remove, r.ID())
+		} else {
+			add = append(add, r.ID())
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	p := func(format string, a ...interface{}) {
+		_, _ = fmt.Fprintf(w, format+"\n", a...)
+	}
+	ruleLine := func(id string) {
+		stat := stats[id]
+		if stat.failures == 0 {
+			p("      - %s", id)
+			return
+		}
+		p("      - %s # %d failures in %d files", id, stat.failures, stat.files)
+	}
+
+	p("# Generated by `protolint init %s`.", strings.Join(flags.FilePaths, " "))
+	p("# The rules failing %d times or more are placed in remove.", flags.RemoveThreshold)
+	p("lint:")
+	writeTargets(p, lint)
+	p("  rules:")
+	p("    no_default: true")
+	p("")
+	p("    add:")
+	for _, id := range add {
+		ruleLine(id)
+	}
+	if 0 < len(remove) {
+		p("")
+		p("    # Fix the failures and move the rules to add.")
+		p("    remove:")
+		for _, id := range remove {
+			ruleLine(id)
+		}
+	}
+
+	if conv.style == "" && conv.newline == "" && conv.maxLineLength == 0 {
+		return
+	}
+	p("")
+	p("  # The options detected from the existing files.")
+	p("  rules_option:")
+	if conv.maxLineLength != 0 {
+		p("    max_line_length:")
+		p("      max_chars: %d", conv.maxLineLength)
+	}
+	if conv.style != "" || conv.newline != "" {
+		p("    indent:")
+		switch conv.style {
+		case "\t":
+			p("      style: tab")
+		case strings.Repeat(" ", 4):
+			p("      style: 4")
+		case strings.Repeat(" ", 2):
+			p("      style: 2")
+		}
+		if conv.newline != "" {
+			p("      newline: %q", conv.newline)
+		}
+	}
+	if conv.newline != "" {
+		p("    imports_sorted:")
+		p("      newline: %q", conv.newline)
+	}
+}
+
+// writeTargets writes the files and the directories to exclude, which are kept from the existing config.
+func writeTargets(
+	p func(format string, a ...interface{}),
+	lint config.Lint,
+) {
+	files, directories := lint.Files, lint.Directories
+	if 0 < len(directories.Exclude) {
+		p("  directories:")
+		p("    exclude:")
+		for _, pattern := range directories.Exclude {
+			p("      - %q", pattern)
+		}
+		p("")
+	}
+	if 0 < len(files.Exclude) || files.RespectIgnoreFiles {
+		p("  files:")
+		if 0 < len(files.Exclude) {
+			p("    exclude:")
+			for _, pattern := range files.Exclude {
+				p("      - %q", pattern)
+			}
+		}
+		if files.RespectIgnoreFiles {
+			p("    respect_ignore_files: true")
+		}
+		p("")
+	}
+}
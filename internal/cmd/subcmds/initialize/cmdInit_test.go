@@ -0,0 +1,92 @@
+package initialize_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds/initialize"
+	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+func TestCmdInit_Run(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		args      []string
+		wantLines []string
+	}{
+		{
+			name: "detect the conventions",
+			args: []string{
+				"-output", "-",
+				setting_test.TestDataPath("init"),
+			},
+			wantLines: []string{
+				"    no_default: true",
+				"      - INDENT",
+				"      - FIELDS_HAVE_COMMENT # 2 failures in 1 files",
+				"      max_chars: 91",
+				"      style: 2",
+				`      newline: "\r\n"`,
+			},
+		},
+		{
+			name: "place the failing rules in remove",
+			args: []string{
+				"-output", "-",
+				"-remove_threshold", "2",
+				setting_test.TestDataPath("init"),
+			},
+			wantLines: []string{
+				"    remove:",
+				"      - FIELDS_HAVE_COMMENT # 2 failures in 1 files",
+			},
+		},
+		{
+			name: "skip the directories excluded by the existing config",
+			args: []string{
+				"-output", "-",
+				"-config_dir_path", setting_test.TestDataPath("init_exclude"),
+				setting_test.TestDataPath("init_exclude"),
+			},
+			wantLines: []string{
+				"  directories:",
+				`      - "**/vendor"`,
+				"      - FIELDS_HAVE_COMMENT",
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			flags, err := initialize.NewFlags(test.args)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			var stdout, stderr bytes.Buffer
+			code := initialize.NewCmdInit(flags, &stdout, &stderr).Run()
+			if code != osutil.ExitSuccess {
+				t.Errorf("got %v, but want %v: %s", code, osutil.ExitSuccess, stderr.String())
+				return
+			}
+
+			lines := strings.Split(stdout.String(), "\n")
+			for _, want := range test.wantLines {
+				if !containsLine(lines, want) {
+					t.Errorf("got %s, but want the line %q", stdout.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func containsLine(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
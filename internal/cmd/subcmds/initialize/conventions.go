@@ -0,0 +1,97 @@
+package initialize
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+)
+
+// The tab width the MAX_LINE_LENGTH rule uses by default.
+const defaultTabChars = 4
+
+// conventions represents the conventions detected from the existing files.
+type conventions struct {
+	// style is the indent style. It is empty when no indented line is found.
+	style string
+	// newline is the most used newline. It is empty when no newline is found.
+	newline string
+	// maxLineLength is the length of the longest line.
+	maxLineLength int
+}
+
+func detectConventions(
+	protoFiles []file.ProtoFile,
+) (conventions, error) {
+	var (
+		tabLines   int
+		spaceLines int
+		twoSpaces  bool
+		newlines   = make(map[string]int)
+		maxLength  int
+	)
+
+	for _, f := range protoFiles {
+		content, err := ioutil.ReadFile(f.Path())
+		if err != nil {
+			return conventions{}, err
+		}
+
+		newlines["\r\n"] += bytes.Count(content, []byte("\r\n"))
+		newlines["\n"] += bytes.Count(content, []byte("\n"))
+		newlines["\r"] += bytes.Count(content, []byte("\r"))
+
+		normalized := strings.Replace(string(content), "\r\n", "\n", -1)
+		normalized = strings.Replace(normalized, "\r", "\n", -1)
+		for _, line := range strings.Split(normalized, "\n") {
+			expanded := strings.Replace(line, "\t", strings.Repeat(" ", defaultTabChars), -1)
+			if length := utf8.RuneCountInString(expanded); maxLength < length {
+				maxLength = length
+			}
+
+			trimmed := strings.TrimSpace(line)
+			// The lines inside a block comment are often aligned by the asterisk.
+			if len(trimmed) == 0 || strings.HasPrefix(trimmed, "*") {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(line, "\t"):
+				tabLines++
+			case strings.HasPrefix(line, " "):
+				spaceLines++
+				indent := len(line) - len(strings.TrimLeft(line, " "))
+				if indent%4 != 0 {
+					twoSpaces = true
+				}
+			}
+		}
+	}
+
+	// "\r\n" is counted as both "\n" and "\r" too.
+	newlines["\n"] -= newlines["\r\n"]
+	newlines["\r"] -= newlines["\r\n"]
+
+	var c conventions
+	switch {
+	case tabLines == 0 && spaceLines == 0:
+	case spaceLines <= tabLines:
+		c.style = "\t"
+	case twoSpaces:
+		c.style = strings.Repeat(" ", 2)
+	default:
+		c.style = strings.Repeat(" ", 4)
+	}
+
+	var newlineCount int
+	// Iterate in the fixed order to break a tie deterministically.
+	for _, newline := range []string{"\n", "\r\n", "\r"} {
+		if newlineCount < newlines[newline] {
+			newlineCount = newlines[newline]
+			c.newline = newline
+		}
+	}
+	c.maxLineLength = maxLength
+	return c, nil
+}
@@ -0,0 +1,77 @@
+package initialize
+
+import (
+	"flag"
+)
+
+const (
+	defaultOutputPath      = ".protolint.yaml"
+	defaultRemoveThreshold = 10
+)
+
+// Flags represents a set of init flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	FilePaths       []string
+	ConfigPath      string
+	ConfigDirPath   string
+	OutputPath      string
+	Force           bool
+	RemoveThreshold int
+	Verbose         bool
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("init", flag.ExitOnError),
+	}
+
+	f.StringVar(
+		&f.ConfigPath,
+		"config_path",
+		"",
+		"path/to/protolint.yaml to read the files and directories to exclude from. Note that if both are set, config_dir_path is ignored.",
+	)
+	f.StringVar(
+		&f.ConfigDirPath,
+		"config_dir_path",
+		"",
+		"path/to/the_directory_including_protolint.yaml",
+	)
+	f.StringVar(
+		&f.OutputPath,
+		"output",
+		defaultOutputPath,
+		"path/to/protolint.yaml to write. Use - to write to stdout",
+	)
+	f.BoolVar(
+		&f.Force,
+		"force",
+		false,
+		"overwrite the existing config file",
+	)
+	f.IntVar(
+		&f.RemoveThreshold,
+		"remove_threshold",
+		defaultRemoveThreshold,
+		"the number of failures from which the rule is placed in remove",
+	)
+	f.BoolVar(
+		&f.Verbose,
+		"v",
+		false,
+		"verbose output that includes parsing process details",
+	)
+
+	_ = f.Parse(args)
+
+	f.FilePaths = f.Args()
+	if len(f.FilePaths) == 0 {
+		f.FilePaths = []string{"."}
+	}
+	return f, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
 	"github.com/tyhal/protolint/internal/linter/config"
 
 	"github.com/tyhal/protolint/internal/linter"
@@ -29,18 +30,28 @@ func NewCmdLint(
 	stdout io.Writer,
 	stderr io.Writer,
 ) (*CmdLint, error) {
-	protoSet, err := file.NewProtoSet(flags.FilePaths)
+	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
 	if err != nil {
 		return nil, err
 	}
+	if err := externalConfig.ValidateRulesOption(subcmds.RulesOptionDocs()); err != nil {
+		return nil, err
+	}
 
-	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
+	protoSet, err := file.NewProtoSet(
+		flags.FilePaths,
+		file.WithSkipDir(externalConfig.ShouldSkipDir),
+		file.WithSkipFile(externalConfig.ShouldSkipFile),
+		file.WithIgnoreFiles(externalConfig.IgnoreFileNames()...),
+		file.WithIgnoreRootDir(externalConfig.DirPath()),
+	)
 	if err != nil {
 		return nil, err
 	}
 	lintConfig := NewCmdLintConfig(
 		externalConfig,
 		flags,
+		file.NewParsedProtoSet(protoSet.ProtoFiles()),
 	)
 
 	output := stderr
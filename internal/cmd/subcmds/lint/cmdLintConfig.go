@@ -16,12 +16,14 @@ type CmdLintConfig struct {
 	verbose  bool
 	reporter report.Reporter
 	plugins  []shared.RuleSet
+	protoSet *file.ParsedProtoSet
 }
 
 // NewCmdLintConfig creates a new CmdLintConfig.
 func NewCmdLintConfig(
 	externalConfig config.ExternalConfig,
 	flags Flags,
+	protoSet *file.ParsedProtoSet,
 ) CmdLintConfig {
 	return CmdLintConfig{
 		external: externalConfig,
@@ -29,6 +31,7 @@ func NewCmdLintConfig(
 		verbose:  flags.Verbose,
 		reporter: flags.Reporter,
 		plugins:  flags.Plugins,
+		protoSet: protoSet,
 	}
 }
 
@@ -36,25 +39,14 @@ func NewCmdLintConfig(
 func (c CmdLintConfig) GenRules(
 	f file.ProtoFile,
 ) ([]rule.HasApply, error) {
-	allRules, err := subcmds.NewAllRules(c.external.Lint.RulesOption, c.fixMode, c.verbose, c.plugins)
+	rs, err := subcmds.NewEnabledRules(c.external, f.DisplayPath(), c.fixMode, c.verbose, c.plugins, c.protoSet)
 	if err != nil {
 		return nil, err
 	}
 
-	var defaultRuleIDs []string
-	if c.external.Lint.Rules.AllDefault {
-		defaultRuleIDs = allRules.IDs()
-	} else {
-		defaultRuleIDs = allRules.Default().IDs()
-	}
-
 	var hasApplies []rule.HasApply
-	for _, r := range allRules {
-		if c.external.ShouldSkipRule(r.ID(), f.DisplayPath(), defaultRuleIDs) {
-			continue
-		}
+	for _, r := range rs {
 		hasApplies = append(hasApplies, r)
 	}
-
 	return hasApplies, nil
 }
@@ -1,13 +1,20 @@
 package list
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/tyhal/protolint/internal/addon/plugin"
+	"github.com/tyhal/protolint/internal/addon/plugin/shared"
 	"github.com/tyhal/protolint/internal/linter/config"
 
 	"github.com/tyhal/protolint/internal/cmd/subcmds"
 	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/internal/stringsutil"
 	"github.com/tyhal/protolint/linter/rule"
 )
 
@@ -15,16 +22,19 @@ import (
 type CmdList struct {
 	stdout io.Writer
 	stderr io.Writer
+	flags  Flags
 }
 
 // NewCmdList creates a new CmdList.
 func NewCmdList(
+	flags Flags,
 	stdout io.Writer,
 	stderr io.Writer,
 ) *CmdList {
 	return &CmdList{
 		stdout: stdout,
 		stderr: stderr,
+		flags:  flags,
 	}
 }
 
@@ -32,23 +42,53 @@ func NewCmdList(
 func (c *CmdList) Run() osutil.ExitCode {
 	err := c.run()
 	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
 		return osutil.ExitInternalFailure
 	}
 	return osutil.ExitSuccess
 }
 
 func (c *CmdList) run() error {
-	rules, err := hasIDAndPurposes()
+	rules, err := ruleInfos(c.flags)
 	if err != nil {
 		return err
 	}
 
+	switch c.flags.Format {
+	case formatJSON:
+		return c.printJSON(rules)
+	case formatMarkdown:
+		return c.printMarkdown(rules)
+	default:
+		return c.printPlain(rules)
+	}
+}
+
+func (c *CmdList) printPlain(rules []ruleInfo) error {
 	for _, r := range rules {
+		if !c.flags.Details {
+			_, err := fmt.Fprintf(c.stdout, "%s: %s\n", r.ID, r.Purpose)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		state := []string{r.state(), r.Source}
+		if 0 < len(r.Options) {
+			options, err := json.Marshal(r.Options)
+			if err != nil {
+				return err
+			}
+			state = append(state, "options="+string(options))
+		}
+
 		_, err := fmt.Fprintf(
 			c.stdout,
-			"%s: %s\n",
-			r.ID(),
-			r.Purpose(),
+			"%s: %s (%s)\n",
+			r.ID,
+			r.Purpose,
+			strings.Join(state, ", "),
 		)
 		if err != nil {
 			return err
@@ -57,20 +97,159 @@ func (c *CmdList) run() error {
 	return nil
 }
 
-type hasIDAndPurpose interface {
-	rule.HasID
-	rule.HasPurpose
+func (c *CmdList) printJSON(rules []ruleInfo) error {
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.stdout, string(data))
+	return err
 }
 
-func hasIDAndPurposes() ([]hasIDAndPurpose, error) {
-	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, false, nil)
+func (c *CmdList) printMarkdown(rules []ruleInfo) error {
+	_, err := fmt.Fprint(
+		c.stdout,
+		"| ID | Purpose | Official | Fixable | Enabled | Source | Options |\n"+
+			"|----|---------|----------|---------|---------|--------|---------|\n",
+	)
+	if err != nil {
+		return err
+	}
+
+	check := func(b bool) string {
+		if b {
+			return "✓"
+		}
+		return ""
+	}
+	escape := func(s string) string {
+		return strings.Replace(s, "|", `\|`, -1)
+	}
+	for _, r := range rules {
+		var options string
+		if 0 < len(r.Options) {
+			data, err := json.Marshal(r.Options)
+			if err != nil {
+				return err
+			}
+			options = "`" + string(data) + "`"
+		}
+
+		_, err = fmt.Fprintf(
+			c.stdout,
+			"| %s | %s | %s | %s | %s | %s | %s |\n",
+			r.ID,
+			escape(r.Purpose),
+			check(r.Official),
+			check(r.Fixable),
+			check(r.Enabled),
+			escape(r.Source),
+			escape(options),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const sourceBuiltIn = "built-in"
+
+// ruleInfo represents a rule to list.
+type ruleInfo struct {
+	ID       string                 `json:"id"`
+	Purpose  string                 `json:"purpose"`
+	Official bool                   `json:"official"`
+	Fixable  bool                   `json:"fixable"`
+	Enabled  bool                   `json:"enabled"`
+	Source   string                 `json:"source"`
+	Options  map[string]interface{} `json:"options,omitempty"`
+}
+
+func (r ruleInfo) state() string {
+	if r.Enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+func ruleInfos(
+	flags Flags,
+) ([]ruleInfo, error) {
+	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
+	if err != nil {
+		return nil, err
+	}
+	option := externalConfig.Lint.RulesOption
+
+	rs, err := subcmds.NewAllRules(option, false, flags.Verbose, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The plugins are asked for their rules once, and each rule is recorded with the plugin path.
+	sources := make(map[string]string)
+	for i, p := range flags.Plugins {
+		es, err := plugin.GetExternalRules([]shared.RuleSet{p}, false, flags.Verbose)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range es {
+			sources[r.ID()] = flags.PluginPaths[i]
+		}
+		rs = append(rs, es...)
+	}
+	enabled, err := subcmds.SelectEnabledRules(externalConfig, "", rs)
 	if err != nil {
 		return nil, err
 	}
+	enabledIDs := enabled.IDs()
 
-	var rules []hasIDAndPurpose
+	var rules []ruleInfo
 	for _, r := range rs {
-		rules = append(rules, r)
+		var fixable bool
+		if f, ok := r.(rule.HasIsFixable); ok {
+			fixable = f.IsFixable()
+		}
+		source, ok := sources[r.ID()]
+		if !ok {
+			source = sourceBuiltIn
+		}
+		var options map[string]interface{}
+		options, err = resolvedOptions(option, r.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, ruleInfo{
+			ID:       r.ID(),
+			Purpose:  r.Purpose(),
+			Official: r.IsOfficial(),
+			Fixable:  fixable,
+			Enabled:  stringsutil.ContainsStringInSlice(r.ID(), enabledIDs),
+			Source:   source,
+			Options:  options,
+		})
 	}
 	return rules, nil
 }
+
+// resolvedOptions returns the configured options of the rule.
+func resolvedOptions(
+	option config.RulesOption,
+	ruleID string,
+) (map[string]interface{}, error) {
+	o, ok := option.Lookup(ruleID)
+	if !ok {
+		return nil, nil
+	}
+	data, err := config.MarshalWithoutZero(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var options map[string]interface{}
+	if err := yaml.Unmarshal(data, &options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
@@ -0,0 +1,178 @@
+package list_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds/list"
+	"github.com/tyhal/protolint/internal/osutil"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+type listedRule struct {
+	ID       string                 `json:"id"`
+	Official bool                   `json:"official"`
+	Fixable  bool                   `json:"fixable"`
+	Enabled  bool                   `json:"enabled"`
+	Source   string                 `json:"source"`
+	Options  map[string]interface{} `json:"options"`
+}
+
+func TestCmdList_Run(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		args      []string
+		wantRules []listedRule
+	}{
+		{
+			name: "json",
+			args: []string{"-format", "json"},
+			wantRules: []listedRule{
+				{ID: "INDENT", Official: true, Fixable: true, Enabled: true, Source: "built-in"},
+				{ID: "ORDER", Official: true, Enabled: true, Source: "built-in"},
+				{ID: "SYNTAX_CONSISTENT", Source: "built-in"},
+			},
+		},
+		{
+			name: "json with the config",
+			args: []string{
+				"-format", "json",
+				"-config_path", setting_test.TestDataPath("list", "protolint.yaml"),
+			},
+			wantRules: []listedRule{
+				{
+					ID:       "INDENT",
+					Official: true,
+					Fixable:  true,
+					Enabled:  true,
+					Source:   "built-in",
+					Options: map[string]interface{}{
+						"style": "tab",
+					},
+				},
+				{
+					ID:       "MAX_LINE_LENGTH",
+					Official: true,
+					Enabled:  true,
+					Source:   "built-in",
+					Options: map[string]interface{}{
+						"max_chars": float64(100),
+					},
+				},
+				{ID: "ORDER", Official: true, Source: "built-in"},
+				{ID: "SYNTAX_CONSISTENT", Enabled: true, Source: "built-in"},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			flags, err := list.NewFlags(test.args)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			var stdout, stderr bytes.Buffer
+			code := list.NewCmdList(flags, &stdout, &stderr).Run()
+			if code != osutil.ExitSuccess {
+				t.Errorf("got %v, but want %v: %s", code, osutil.ExitSuccess, stderr.String())
+				return
+			}
+
+			var got []listedRule
+			if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			rules := make(map[string]listedRule)
+			for _, r := range got {
+				rules[r.ID] = r
+			}
+
+			for _, want := range test.wantRules {
+				if !reflect.DeepEqual(rules[want.ID], want) {
+					t.Errorf("got %v, but want %v", rules[want.ID], want)
+				}
+			}
+		})
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		flags, err := list.NewFlags([]string{
+			"-config_path", setting_test.TestDataPath("list", "protolint.yaml"),
+		})
+		if err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+
+		var stdout, stderr bytes.Buffer
+		code := list.NewCmdList(flags, &stdout, &stderr).Run()
+		if code != osutil.ExitSuccess {
+			t.Errorf("got %v, but want %v: %s", code, osutil.ExitSuccess, stderr.String())
+			return
+		}
+
+		want := "\nINDENT: Enforces a consistent indentation style.\n"
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("got %q, but want to contain %q", stdout.String(), want)
+		}
+	})
+
+	t.Run("plain with the details", func(t *testing.T) {
+		flags, err := list.NewFlags([]string{
+			"-details",
+			"-config_path", setting_test.TestDataPath("list", "protolint.yaml"),
+		})
+		if err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+
+		var stdout, stderr bytes.Buffer
+		code := list.NewCmdList(flags, &stdout, &stderr).Run()
+		if code != osutil.ExitSuccess {
+			t.Errorf("got %v, but want %v: %s", code, osutil.ExitSuccess, stderr.String())
+			return
+		}
+
+		for _, want := range []string{
+			"INDENT: Enforces a consistent indentation style. (enabled, built-in, options={\"style\":\"tab\"})\n",
+			"ORDER: Verifies that all files should be ordered in the specific manner. (disabled, built-in)\n",
+		} {
+			if !strings.Contains(stdout.String(), want) {
+				t.Errorf("got %q, but want to contain %q", stdout.String(), want)
+			}
+		}
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		flags, err := list.NewFlags([]string{"-format", "markdown"})
+		if err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+
+		var stdout, stderr bytes.Buffer
+		code := list.NewCmdList(flags, &stdout, &stderr).Run()
+		if code != osutil.ExitSuccess {
+			t.Errorf("got %v, but want %v: %s", code, osutil.ExitSuccess, stderr.String())
+			return
+		}
+
+		want := "| INDENT | Enforces a consistent indentation style. | ✓ | ✓ | ✓ | built-in |  |\n"
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("got %q, but want to contain %q", stdout.String(), want)
+		}
+	})
+}
+
+func TestNewFlags(t *testing.T) {
+	_, err := list.NewFlags([]string{"-format", "xml"})
+	if err == nil {
+		t.Errorf("got nil, but want err")
+	}
+}
@@ -0,0 +1,90 @@
+package list
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tyhal/protolint/internal/addon/plugin/shared"
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+)
+
+const (
+	formatPlain    = "plain"
+	formatJSON     = "json"
+	formatMarkdown = "markdown"
+)
+
+// Flags represents a set of list flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	Format        string
+	Details       bool
+	ConfigPath    string
+	ConfigDirPath string
+	Verbose       bool
+	Plugins       []shared.RuleSet
+	PluginPaths   []string
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("list", flag.ExitOnError),
+	}
+	var pf subcmds.PluginFlag
+
+	f.StringVar(
+		&f.Format,
+		"format",
+		formatPlain,
+		"output format. Available formats are plain, json and markdown",
+	)
+	f.BoolVar(
+		&f.Details,
+		"details",
+		false,
+		"print the state, the source and the options of each rule in the plain format",
+	)
+	f.StringVar(
+		&f.ConfigPath,
+		"config_path",
+		"",
+		"path/to/protolint.yaml. Note that if both are set, config_dir_path is ignored.",
+	)
+	f.StringVar(
+		&f.ConfigDirPath,
+		"config_dir_path",
+		"",
+		"path/to/the_directory_including_protolint.yaml",
+	)
+	f.Var(
+		&pf,
+		"plugin",
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'`,
+	)
+	f.BoolVar(
+		&f.Verbose,
+		"v",
+		false,
+		"verbose output that includes parsing process details",
+	)
+
+	_ = f.Parse(args)
+
+	switch f.Format {
+	case formatPlain, formatJSON, formatMarkdown:
+	default:
+		return Flags{}, fmt.Errorf("%s is an invalid format. valid format is plain, json or markdown", f.Format)
+	}
+
+	plugins, err := pf.BuildPlugins(f.Verbose)
+	if err != nil {
+		return Flags{}, err
+	}
+	f.Plugins = plugins
+	f.PluginPaths = pf.Paths()
+	return f, nil
+}
@@ -64,3 +64,8 @@ func (f *PluginFlag) BuildPlugins(verbose bool) ([]shared.RuleSet, error) {
 	}
 	return plugins, nil
 }
+
+// Paths returns the paths of the plugins in the order of BuildPlugins.
+func (f *PluginFlag) Paths() []string {
+	return f.raws
+}
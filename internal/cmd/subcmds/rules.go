@@ -5,7 +5,9 @@ import (
 	"github.com/tyhal/protolint/internal/addon/plugin/shared"
 	"github.com/tyhal/protolint/internal/addon/rules"
 	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/linter/file"
 	internalrule "github.com/tyhal/protolint/internal/linter/rule"
+	"github.com/tyhal/protolint/linter/rule"
 )
 
 // NewAllRules creates new all rules.
@@ -15,7 +17,17 @@ func NewAllRules(
 	verbose bool,
 	plugins []shared.RuleSet,
 ) (internalrule.Rules, error) {
-	rs := newAllInternalRules(option, fixMode)
+	return newAllRules(option, fixMode, verbose, plugins, nil)
+}
+
+func newAllRules(
+	option config.RulesOption,
+	fixMode bool,
+	verbose bool,
+	plugins []shared.RuleSet,
+	protoSet *file.ParsedProtoSet,
+) (internalrule.Rules, error) {
+	rs := newAllInternalRules(option, fixMode, protoSet)
 
 	es, err := plugin.GetExternalRules(plugins, fixMode, verbose)
 	if err != nil {
@@ -25,15 +37,90 @@ func NewAllRules(
 	return rs, nil
 }
 
+// NewEnabledRules creates the rules enabled for the file under the external config.
+// The overrides matching the file are applied.
+// protoSet is the linted set the rules verifying the consistency across the files look at. It can be nil.
+func NewEnabledRules(
+	externalConfig config.ExternalConfig,
+	displayPath string,
+	fixMode bool,
+	verbose bool,
+	plugins []shared.RuleSet,
+	protoSet *file.ParsedProtoSet,
+) (internalrule.Rules, error) {
+	external, err := externalConfig.Resolve(displayPath)
+	if err != nil {
+		return nil, err
+	}
+
+	allRules, err := newAllRules(external.Lint.RulesOption, fixMode, verbose, plugins, protoSet)
+	if err != nil {
+		return nil, err
+	}
+	return selectEnabledRules(external, displayPath, allRules), nil
+}
+
+// SelectEnabledRules selects the rules enabled for the file under the external config from allRules.
+// Unlike NewEnabledRules, it doesn't ask the plugins for their rules again.
+func SelectEnabledRules(
+	externalConfig config.ExternalConfig,
+	displayPath string,
+	allRules internalrule.Rules,
+) (internalrule.Rules, error) {
+	external, err := externalConfig.Resolve(displayPath)
+	if err != nil {
+		return nil, err
+	}
+	return selectEnabledRules(external, displayPath, allRules), nil
+}
+
+func selectEnabledRules(
+	external config.ExternalConfig,
+	displayPath string,
+	allRules internalrule.Rules,
+) internalrule.Rules {
+	var defaultRuleIDs []string
+	if external.Lint.Rules.AllDefault {
+		defaultRuleIDs = allRules.IDs()
+	} else {
+		defaultRuleIDs = allRules.Default().IDs()
+	}
+
+	var enabled internalrule.Rules
+	for _, r := range allRules {
+		if external.ShouldSkipRule(r.ID(), displayPath, defaultRuleIDs) {
+			continue
+		}
+		enabled = append(enabled, r)
+	}
+	return enabled
+}
+
+// RulesOptionDocs returns the docs of the options of the internal rules keyed by the rule IDs.
+func RulesOptionDocs() map[string][]rule.OptionDoc {
+	return newAllInternalRules(config.RulesOption{}, false, nil).OptionDocs()
+}
+
 func newAllInternalRules(
 	option config.RulesOption,
 	fixMode bool,
+	protoSet *file.ParsedProtoSet,
 ) internalrule.Rules {
 	syntaxConsistent := option.SyntaxConsistent
+	editionsFeatures := option.EditionsFeatures
+	proto2ConstructsAvoid := option.Proto2ConstructsAvoid
 	fileNamesLowerSnakeCase := option.FileNamesLowerSnakeCase
 	indent := option.Indent
 	maxLineLength := option.MaxLineLength
+	maxFileLines := option.MaxFileLines
+	maxMessageFields := option.MaxMessageFields
+	maxMessageNestingDepth := option.MaxMessageNestingDepth
+	maxEnumValues := option.MaxEnumValues
+	maxServiceRPCs := option.MaxServiceRPCs
+	maxOneofFields := option.MaxOneofFields
 	enumFieldNamesZeroValueEndWith := option.EnumFieldNamesZeroValueEndWith
+	enumFieldNamesPrefix := option.EnumFieldNamesPrefix
+	enumZeroValueNotSemantic := option.EnumZeroValueNotSemantic
 	importsSorted := option.ImportsSorted
 	serviceNamesEndWith := option.ServiceNamesEndWith
 	fieldNamesExcludePrepositions := option.FieldNamesExcludePrepositions
@@ -41,15 +128,36 @@ func newAllInternalRules(
 	messagesHaveComment := option.MessagesHaveComment
 	servicesHaveComment := option.ServicesHaveComment
 	rpcsHaveComment := option.RPCsHaveComment
+	rpcRequestResponseNaming := option.RPCRequestResponseNaming
+	rpcsAvoidStreaming := option.RPCsAvoidStreaming
+	rpcListPagination := option.RPCListPagination
+	rpcUpdateFieldMask := option.RPCUpdateFieldMask
 	fieldsHaveComment := option.FieldsHaveComment
 	enumsHaveComment := option.EnumsHaveComment
 	enumFieldsHaveComment := option.EnumFieldsHaveComment
 	repeatedFieldNamesPluralized := option.RepeatedFieldNamesPluralized
+	mapKeyTypes := option.MapKeyTypes
+	mapFieldNamesPluralized := option.MapFieldNamesPluralized
+	oneofsHaveComment := option.OneofsHaveComment
+	deprecatedElementsHaveComment := option.DeprecatedElementsHaveComment
+	commentsMinWords := option.CommentsMinWords
+	commentsTodoReference := option.CommentsTodoReference
+	commentsMatchPattern := option.CommentsMatchPattern
+	spelling := option.Spelling
+	deletedFieldsUseReserved := option.DeletedFieldsUseReserved
+	fieldsPreferWellKnownTypes := option.FieldsPreferWellKnownTypes
+	fieldsAvoidDiscouragedTypes := option.FieldsAvoidDiscouragedTypes
+	fileOptionsRequired := option.FileOptionsRequired
+	fileOptionsConsistent := option.FileOptionsConsistent
+	fileOptionsMatchTemplate := option.FileOptionsMatchTemplate
 
 	return internalrule.Rules{
 		rules.NewSyntaxConsistentRule(
 			syntaxConsistent.Version,
 		),
+		rules.NewEditionsFeaturesRule(
+			editionsFeatures.AllowedFeatures,
+		),
 		rules.NewFileNamesLowerSnakeCaseRule(
 			fileNamesLowerSnakeCase.Excludes,
 		),
@@ -63,8 +171,43 @@ func newAllInternalRules(
 			maxLineLength.MaxChars,
 			maxLineLength.TabChars,
 		),
+		rules.NewMaxFileLinesRule(
+			maxFileLines.MaxLines,
+		),
+		rules.NewMaxMessageFieldsRule(
+			maxMessageFields.MaxFields,
+		),
+		rules.NewMaxMessageNestingDepthRule(
+			maxMessageNestingDepth.MaxDepth,
+		),
+		rules.NewMaxEnumValuesRule(
+			maxEnumValues.MaxValues,
+		),
+		rules.NewMaxServiceRPCsRule(
+			maxServiceRPCs.MaxRPCs,
+		),
+		rules.NewMaxOneofFieldsRule(
+			maxOneofFields.MaxFields,
+		),
 
 		rules.NewPackageNameLowerCaseRule(),
+		rules.NewPackageDefinedRule(),
+		rules.NewPackageVersionSuffixRule(),
+		rules.NewPackageDirectoryMatchRule(),
+		rules.NewPackageSameInDirectoryRule(
+			protoSet,
+		),
+
+		rules.NewFileOptionsRequiredRule(
+			fileOptionsRequired.Options,
+		),
+		rules.NewFileOptionsConsistentRule(
+			fileOptionsConsistent.Options,
+			protoSet,
+		),
+		rules.NewFileOptionsMatchTemplateRule(
+			fileOptionsMatchTemplate.Templates,
+		),
 
 		rules.NewImportsSortedRule(
 			importsSorted.Newline,
@@ -75,6 +218,19 @@ func newAllInternalRules(
 		rules.NewEnumFieldNamesZeroValueEndWithRule(
 			enumFieldNamesZeroValueEndWith.Suffix,
 		),
+		rules.NewEnumFieldNamesPrefixRule(
+			enumFieldNamesPrefix.TrimSuffixes,
+			enumFieldNamesPrefix.Prefixes,
+			fixMode,
+		),
+		rules.NewEnumFirstValueZeroRule(),
+		rules.NewEnumZeroValueUniqueRule(),
+		rules.NewEnumZeroValueNotSemanticRule(
+			enumZeroValueNotSemantic.SemanticNames,
+			enumZeroValueNotSemantic.Excludes,
+			enumFieldNamesPrefix.TrimSuffixes,
+			enumFieldNamesPrefix.Prefixes,
+		),
 		rules.NewEnumFieldsHaveCommentRule(
 			enumFieldsHaveComment.ShouldFollowGolangStyle,
 		),
@@ -83,6 +239,7 @@ func newAllInternalRules(
 		rules.NewEnumsHaveCommentRule(
 			enumsHaveComment.ShouldFollowGolangStyle,
 		),
+		rules.NewEnumAllowAliasAvoidReservedRule(),
 
 		rules.NewFieldNamesLowerSnakeCaseRule(),
 		rules.NewFieldNamesExcludePrepositionsRule(
@@ -94,12 +251,50 @@ func newAllInternalRules(
 		),
 		rules.NewProto3FieldsAvoidRequiredRule(),
 		rules.NewProto3GroupsAvoidRule(),
+		rules.NewProto2ConstructsAvoidRule(
+			proto2ConstructsAvoid.Constructs,
+		),
+		rules.NewFieldNumbersAvoidImplementationRangeRule(),
+		rules.NewFieldNumbersWithinMaxRule(),
+		rules.NewFieldNumbersUniqueRule(),
+		rules.NewFieldNumbersGapsReservedRule(),
+		rules.NewFieldNumbersAscendingRule(),
+		rules.NewReservedNotUsedRule(),
+		rules.NewReservedRangesNotOverlapRule(),
+		rules.NewDeletedFieldsUseReservedRule(
+			deletedFieldsUseReserved.Keywords,
+		),
+		rules.NewFieldsPreferWellKnownTypesRule(
+			fieldsPreferWellKnownTypes.TimestampSuffixes,
+			fieldsPreferWellKnownTypes.DurationSuffixes,
+			fieldsPreferWellKnownTypes.UnitSuffixes,
+			fieldsPreferWellKnownTypes.FieldMaskSuffixes,
+		),
+		rules.NewFieldsAvoidDiscouragedTypesRule(
+			fieldsAvoidDiscouragedTypes.Types,
+			fieldsAvoidDiscouragedTypes.AllowedFields,
+		),
 		rules.NewRepeatedFieldNamesPluralizedRule(
 			repeatedFieldNamesPluralized.PluralRules,
 			repeatedFieldNamesPluralized.SingularRules,
 			repeatedFieldNamesPluralized.UncountableRules,
 			repeatedFieldNamesPluralized.IrregularRules,
 		),
+		rules.NewMapKeyTypesRule(
+			mapKeyTypes.Types,
+		),
+		rules.NewMapFieldNamesPluralizedRule(
+			mapFieldNamesPluralized.PluralRules,
+			mapFieldNamesPluralized.SingularRules,
+			mapFieldNamesPluralized.UncountableRules,
+			mapFieldNamesPluralized.IrregularRules,
+		),
+
+		rules.NewOneofNamesLowerSnakeCaseRule(),
+		rules.NewOneofsHaveCommentRule(
+			oneofsHaveComment.ShouldFollowGolangStyle,
+		),
+		rules.NewOneofsAvoidSingleFieldRule(),
 
 		rules.NewMessageNamesUpperCamelCaseRule(),
 		rules.NewMessageNamesExcludePrepositionsRule(
@@ -114,6 +309,29 @@ func newAllInternalRules(
 		rules.NewRPCsHaveCommentRule(
 			rpcsHaveComment.ShouldFollowGolangStyle,
 		),
+		rules.NewRPCRequestResponseNamingRule(
+			rpcRequestResponseNaming.RequestSuffix,
+			rpcRequestResponseNaming.ResponseSuffix,
+		),
+		rules.NewRPCRequestResponseUniqueRule(),
+		rules.NewRPCNoWellKnownEmptyRule(),
+		rules.NewRPCsAvoidStreamingRule(
+			rpcsAvoidStreaming.AllowedServices,
+			rpcsAvoidStreaming.AllowedRPCs,
+			rpcsAvoidStreaming.RequireComment,
+		),
+		rules.NewRPCListPaginationRule(
+			rpcListPagination.RPCs,
+			rpcListPagination.PageSizeField,
+			rpcListPagination.PageTokenField,
+			rpcListPagination.NextPageTokenField,
+			protoSet,
+		),
+		rules.NewRPCUpdateFieldMaskRule(
+			rpcUpdateFieldMask.RPCs,
+			rpcUpdateFieldMask.UpdateMaskField,
+			protoSet,
+		),
 
 		rules.NewServiceNamesUpperCamelCaseRule(),
 		rules.NewServiceNamesEndWithRule(
@@ -122,5 +340,43 @@ func newAllInternalRules(
 		rules.NewServicesHaveCommentRule(
 			servicesHaveComment.ShouldFollowGolangStyle,
 		),
+
+		rules.NewCommentsMinWordsRule(
+			commentsMinWords.MinWords,
+		),
+		rules.NewCommentsEndWithPeriodRule(),
+		rules.NewCommentsTodoReferenceRule(
+			commentsTodoReference.IssuePattern,
+		),
+		rules.NewCommentsAvoidCommentedOutCodeRule(),
+		rules.NewCommentsMatchPatternRule(
+			commentsMatchPattern.Pattern,
+		),
+		rules.NewSpellingRule(
+			spelling.Words,
+			spelling.Dictionaries,
+		),
+
+		rules.NewDeprecatedElementsHaveCommentRule(
+			deprecatedElementsHaveComment.RemovalDatePattern,
+		),
+		rules.NewReferencesAvoidDeprecatedTypesRule(
+			protoSet,
+		),
+
+		rules.NewAIPHTTPAnnotationRule(),
+		rules.NewAIPHTTPMethodRule(),
+		rules.NewAIPHTTPBodyRule(
+			protoSet,
+		),
+		rules.NewAIPHTTPPathVariablesRule(
+			protoSet,
+		),
+		rules.NewAIPResourceAnnotationRule(
+			protoSet,
+		),
+		rules.NewAIPFieldBehaviorRule(
+			protoSet,
+		),
 	}
 }
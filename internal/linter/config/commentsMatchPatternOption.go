@@ -0,0 +1,6 @@
+package config
+
+// CommentsMatchPatternOption represents the option for the COMMENTS_MATCH_PATTERN rule.
+type CommentsMatchPatternOption struct {
+	Pattern string `yaml:"pattern"`
+}
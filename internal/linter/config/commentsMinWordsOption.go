@@ -0,0 +1,6 @@
+package config
+
+// CommentsMinWordsOption represents the option for the COMMENTS_MIN_WORDS rule.
+type CommentsMinWordsOption struct {
+	MinWords int `yaml:"min_words"`
+}
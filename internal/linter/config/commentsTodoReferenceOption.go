@@ -0,0 +1,6 @@
+package config
+
+// CommentsTodoReferenceOption represents the option for the COMMENTS_TODO_REFERENCE rule.
+type CommentsTodoReferenceOption struct {
+	IssuePattern string `yaml:"issue_pattern"`
+}
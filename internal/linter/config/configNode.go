@@ -0,0 +1,132 @@
+package config
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// configNode is a parsed config file to look up the position of each element.
+type configNode struct {
+	filePath string
+	root     *yaml3.Node
+}
+
+func newConfigNode(
+	filePath string,
+	data []byte,
+) (configNode, error) {
+	var doc yaml3.Node
+	if err := yaml3.Unmarshal(data, &doc); err != nil {
+		return configNode{}, err
+	}
+	n := configNode{
+		filePath: filePath,
+	}
+	if 0 < len(doc.Content) {
+		n.root = doc.Content[0]
+	}
+	return n, nil
+}
+
+// lookup finds the node following the path, which consists of mapping keys and sequence indexes.
+func (n configNode) lookup(path ...interface{}) *yaml3.Node {
+	node := n.root
+	for _, p := range path {
+		if node == nil {
+			return nil
+		}
+		switch key := p.(type) {
+		case string:
+			node = mappingValue(node, key)
+		case int:
+			node = sequenceItem(node, key)
+		default:
+			return nil
+		}
+	}
+	return node
+}
+
+// pos returns the position of the node following the path.
+// It falls back to the nearest existing ancestor.
+func (n configNode) pos(path ...interface{}) meta.Position {
+	for i := len(path); 0 <= i; i-- {
+		if node := n.lookup(path[:i]...); node != nil {
+			return meta.Position{
+				Filename: n.filePath,
+				Line:     node.Line,
+				Column:   node.Column,
+			}
+		}
+	}
+	return meta.Position{
+		Filename: n.filePath,
+		Line:     1,
+		Column:   1,
+	}
+}
+
+// keyPos returns the position of the mapping key at the end of the path.
+func (n configNode) keyPos(path ...interface{}) meta.Position {
+	if len(path) == 0 {
+		return n.pos()
+	}
+	parent := n.lookup(path[:len(path)-1]...)
+	key, ok := path[len(path)-1].(string)
+	if parent == nil || !ok || parent.Kind != yaml3.MappingNode {
+		return n.pos(path...)
+	}
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			return meta.Position{
+				Filename: n.filePath,
+				Line:     parent.Content[i].Line,
+				Column:   parent.Content[i].Column,
+			}
+		}
+	}
+	return n.pos(path...)
+}
+
+// mappingKeys returns the keys of the mapping node following the path.
+func (n configNode) mappingKeys(path ...interface{}) []string {
+	node := n.lookup(path...)
+	if node == nil || node.Kind != yaml3.MappingNode {
+		return nil
+	}
+	var keys []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+// encode encodes the node following the path into YAML.
+func (n configNode) encode(path ...interface{}) ([]byte, error) {
+	return yaml3.Marshal(n.lookup(path...))
+}
+
+func mappingValue(
+	node *yaml3.Node,
+	key string,
+) *yaml3.Node {
+	if node.Kind != yaml3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func sequenceItem(
+	node *yaml3.Node,
+	index int,
+) *yaml3.Node {
+	if node.Kind != yaml3.SequenceNode || index < 0 || len(node.Content) <= index {
+		return nil
+	}
+	return node.Content[index]
+}
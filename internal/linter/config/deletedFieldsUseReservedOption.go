@@ -0,0 +1,6 @@
+package config
+
+// DeletedFieldsUseReservedOption represents the option for the DELETED_FIELDS_USE_RESERVED rule.
+type DeletedFieldsUseReservedOption struct {
+	Keywords []string `yaml:"keywords"`
+}
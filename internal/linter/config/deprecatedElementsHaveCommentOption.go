@@ -0,0 +1,6 @@
+package config
+
+// DeprecatedElementsHaveCommentOption represents the option for the DEPRECATED_ELEMENTS_HAVE_COMMENT rule.
+type DeprecatedElementsHaveCommentOption struct {
+	RemovalDatePattern string `yaml:"removal_date_pattern"`
+}
@@ -1,9 +1,6 @@
 package config
 
-import (
-	"os"
-	"strings"
-)
+import "github.com/tyhal/protolint/internal/pathutil"
 
 // Directories represents the target directories.
 type Directories struct {
@@ -13,10 +10,17 @@ type Directories struct {
 func (d Directories) shouldSkipRule(
 	displayPath string,
 ) bool {
-	for _, exclude := range d.Exclude {
-		if strings.HasPrefix(displayPath, exclude+string(os.PathSeparator)) {
-			return true
-		}
+	return pathutil.Patterns(d.Exclude).MatchDir(displayPath)
+}
+
+// shouldSkipDir decides whether to stop walking the directory.
+// A negated pattern can re-include a descendant, so it requires walking all directories.
+func (d Directories) shouldSkipDir(
+	displayPath string,
+) bool {
+	ps := pathutil.Patterns(d.Exclude)
+	if ps.HasNegation() {
+		return false
 	}
-	return false
+	return ps.Match(displayPath) || ps.MatchDir(displayPath)
 }
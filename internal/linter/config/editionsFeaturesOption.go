@@ -0,0 +1,6 @@
+package config
+
+// EditionsFeaturesOption represents the option for the EDITIONS_FEATURES rule.
+type EditionsFeaturesOption struct {
+	AllowedFeatures []string `yaml:"allowed_features"`
+}
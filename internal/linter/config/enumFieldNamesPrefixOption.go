@@ -0,0 +1,7 @@
+package config
+
+// EnumFieldNamesPrefixOption represents the option for the ENUM_FIELD_NAMES_PREFIX rule.
+type EnumFieldNamesPrefixOption struct {
+	TrimSuffixes []string          `yaml:"trim_suffixes"`
+	Prefixes     map[string]string `yaml:"prefixes"`
+}
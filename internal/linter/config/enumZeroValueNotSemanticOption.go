@@ -0,0 +1,7 @@
+package config
+
+// EnumZeroValueNotSemanticOption represents the option for the ENUM_ZERO_VALUE_NOT_SEMANTIC rule.
+type EnumZeroValueNotSemanticOption struct {
+	SemanticNames []string `yaml:"semantic_names"`
+	Excludes      []string `yaml:"excludes"`
+}
@@ -1,5 +1,7 @@
 package config
 
+import "path/filepath"
+
 // Lint represents the lint configuration.
 type Lint struct {
 	Ignores     Ignores
@@ -7,11 +9,15 @@ type Lint struct {
 	Directories Directories
 	Rules       Rules
 	RulesOption RulesOption `yaml:"rules_option"`
+	Overrides   Overrides
 }
 
 // ExternalConfig represents the external configuration.
 type ExternalConfig struct {
 	Lint Lint
+	// The absolute path of the directory of the config file, which the patterns in the config are relative to.
+	// It is empty if no config file is loaded, and then the patterns are relative to the working directory.
+	dirPath string
 }
 
 // ShouldSkipRule checks whether to skip applying the rule to the file.
@@ -20,9 +26,91 @@ func (c ExternalConfig) ShouldSkipRule(
 	displayPath string,
 	defaultRuleIDs []string,
 ) bool {
+	displayPath = c.configRelPath(displayPath)
 	lint := c.Lint
 	return lint.Ignores.shouldSkipRule(ruleID, displayPath) ||
 		lint.Files.shouldSkipRule(displayPath) ||
 		lint.Directories.shouldSkipRule(displayPath) ||
 		lint.Rules.shouldSkipRule(ruleID, defaultRuleIDs)
 }
+
+// Resolve returns the config applied to the file, which merges the matching overrides.
+func (c ExternalConfig) Resolve(
+	displayPath string,
+) (ExternalConfig, error) {
+	lint, err := c.Lint.Overrides.apply(c.Lint, c.configRelPath(displayPath))
+	if err != nil {
+		return c, err
+	}
+	lint.Overrides = nil
+	return ExternalConfig{
+		Lint:    lint,
+		dirPath: c.dirPath,
+	}, nil
+}
+
+// configRelPath returns the path of the file relative to the directory of the config file,
+// so that the patterns match the same files wherever protolint runs.
+func (c ExternalConfig) configRelPath(
+	displayPath string,
+) string {
+	return relPath(c.dirPath, displayPath)
+}
+
+// relPath returns the path relative to the directory.
+// The path is returned as it is if dirPath is empty.
+func relPath(
+	dirPath string,
+	displayPath string,
+) string {
+	if dirPath == "" {
+		return displayPath
+	}
+	absPath, err := filepath.Abs(displayPath)
+	if err != nil {
+		return displayPath
+	}
+	rel, err := filepath.Rel(dirPath, absPath)
+	if err != nil {
+		return displayPath
+	}
+	return rel
+}
+
+// resolvePaths resolves the relative paths in the rules options against the directory of the config file.
+func (c *ExternalConfig) resolvePaths(dirPath string) error {
+	c.Lint.RulesOption.Spelling = c.Lint.RulesOption.Spelling.resolvePaths(dirPath)
+	for i := range c.Lint.Overrides {
+		if err := c.Lint.Overrides[i].RulesOption.resolvePaths(dirPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ShouldSkipDir checks whether to skip walking the directory.
+func (c ExternalConfig) ShouldSkipDir(
+	displayPath string,
+) bool {
+	return c.Lint.Directories.shouldSkipDir(c.configRelPath(displayPath))
+}
+
+// ShouldSkipFile checks whether to skip collecting the file.
+func (c ExternalConfig) ShouldSkipFile(
+	displayPath string,
+) bool {
+	displayPath = c.configRelPath(displayPath)
+	lint := c.Lint
+	return lint.Files.shouldSkipRule(displayPath) ||
+		lint.Directories.shouldSkipRule(displayPath)
+}
+
+// DirPath returns the absolute path of the directory of the config file, or the empty string without the config file.
+func (c ExternalConfig) DirPath() string {
+	return c.dirPath
+}
+
+// IgnoreFileNames returns the names of the ignore files to respect.
+func (c ExternalConfig) IgnoreFileNames() []string {
+	return c.Lint.Files.ignoreFileNames()
+}
@@ -14,6 +14,8 @@ const (
 )
 
 // GetExternalConfig provides the externalConfig.
+// The patterns of the paths are matched against the paths relative to the directory of the config file,
+// and the relative paths of the dictionaries are resolved against it.
 func GetExternalConfig(
 	filePath string,
 	dirPath string,
@@ -38,6 +40,13 @@ func GetExternalConfig(
 	if err := yaml.UnmarshalStrict(data, &config); err != nil {
 		return config, err
 	}
+	if err := config.resolvePaths(filepath.Dir(filePath)); err != nil {
+		return config, err
+	}
+	config.dirPath, err = filepath.Abs(filepath.Dir(filePath))
+	if err != nil {
+		return config, err
+	}
 
 	return config, nil
 }
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -87,8 +88,53 @@ func TestGetExternalConfig(t *testing.T) {
 				return
 			}
 
-			if !reflect.DeepEqual(got, test.wantExternalConfig) {
-				t.Errorf("got %v, but want %v", got, test.wantExternalConfig)
+			if !reflect.DeepEqual(got.Lint, test.wantExternalConfig.Lint) {
+				t.Errorf("got %v, but want %v", got.Lint, test.wantExternalConfig.Lint)
+			}
+		})
+	}
+}
+
+func TestGetExternalConfigResolvesDictionaryPaths(t *testing.T) {
+	dirPath := setting_test.TestDataPath("spellingconfig")
+	externalConfig, err := config.GetExternalConfig("", dirPath)
+	if err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name             string
+		inputDisplayPath string
+		wantDictionaries []string
+	}{
+		{
+			name:             "the dictionaries of the base option",
+			inputDisplayPath: filepath.Join(dirPath, "book.proto"),
+			wantDictionaries: []string{
+				filepath.Join(dirPath, "dictionaries", "acme.txt"),
+				"/usr/share/dict/words",
+			},
+		},
+		{
+			name:             "the dictionaries of the override",
+			inputDisplayPath: filepath.Join(dirPath, "legacy", "book.proto"),
+			wantDictionaries: []string{
+				filepath.Join(dirPath, "dictionaries", "legacy.txt"),
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resolved, err := externalConfig.Resolve(test.inputDisplayPath)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			got := resolved.Lint.RulesOption.Spelling.Dictionaries
+			if !reflect.DeepEqual(got, test.wantDictionaries) {
+				t.Errorf("got %v, but want %v", got, test.wantDictionaries)
 			}
 		})
 	}
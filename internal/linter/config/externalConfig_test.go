@@ -1,10 +1,17 @@
 package config_test
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
+	yaml "gopkg.in/yaml.v2"
+
 	"github.com/tyhal/protolint/internal/cmd/subcmds"
 	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/setting_test"
 )
 
 func TestExternalConfig_ShouldSkipRule(t *testing.T) {
@@ -84,6 +91,31 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 		},
 	}
 
+	globExternalConfig := config.ExternalConfig{
+		Lint: config.Lint{
+			Ignores: []config.Ignore{
+				{
+					ID: "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					Files: []string{
+						"third_party/**/*.proto",
+						"!third_party/acme/**",
+					},
+				},
+			},
+			Files: config.Files{
+				Exclude: []string{
+					"**/*_gen.proto",
+				},
+			},
+			Directories: config.Directories{
+				Exclude: []string{
+					"**/vendor",
+					"!**/vendor/acme",
+				},
+			},
+		},
+	}
+
 	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, false, nil)
 	if err != nil {
 		t.Error(err)
@@ -181,6 +213,54 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
 			inputDisplayPath: "path/to/dir3/bar.proto",
 		},
+		{
+			name:             "ignore the rule for the file matching the glob",
+			externalConfig:   globExternalConfig,
+			inputRuleID:      "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			inputDisplayPath: "third_party/foo/bar.proto",
+			inputDefaultRuleIDs: []string{
+				"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "not ignore the rule for the file matching the negated glob",
+			externalConfig:   globExternalConfig,
+			inputRuleID:      "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			inputDisplayPath: "third_party/acme/bar.proto",
+			inputDefaultRuleIDs: []string{
+				"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			},
+		},
+		{
+			name:             "exclude the file matching the glob",
+			externalConfig:   globExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "../path/to/foo_gen.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "exclude the directory matching the glob",
+			externalConfig:   globExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "path/vendor/foo/bar.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "not exclude the directory matching the negated glob",
+			externalConfig:   globExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "path/vendor/acme/bar.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+		},
 	} {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
@@ -195,3 +275,342 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalConfig_ShouldSkipDir(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		inputExclude     []string
+		inputDisplayPath string
+		wantSkipDir      bool
+	}{
+		{
+			name:             "skip the excluded directory",
+			inputExclude:     []string{"**/vendor"},
+			inputDisplayPath: "path/vendor",
+			wantSkipDir:      true,
+		},
+		{
+			name:             "skip the child of the excluded directory",
+			inputExclude:     []string{"path/to"},
+			inputDisplayPath: "path/to/child",
+			wantSkipDir:      true,
+		},
+		{
+			name:             "not skip the other directory",
+			inputExclude:     []string{"**/vendor"},
+			inputDisplayPath: "path/to",
+		},
+		{
+			name:             "not skip any directories with a negated pattern",
+			inputExclude:     []string{"**/vendor", "!**/vendor/acme"},
+			inputDisplayPath: "path/vendor",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := config.ExternalConfig{
+				Lint: config.Lint{
+					Directories: config.Directories{
+						Exclude: test.inputExclude,
+					},
+				},
+			}
+			got := c.ShouldSkipDir(test.inputDisplayPath)
+			if got != test.wantSkipDir {
+				t.Errorf("got %v, but want %v", got, test.wantSkipDir)
+			}
+		})
+	}
+}
+
+func TestExternalConfig_ShouldSkipFile(t *testing.T) {
+	dirPath := setting_test.TestDataPath("configrelative")
+	externalConfig, err := config.GetExternalConfig("", dirPath)
+	if err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	for _, test := range []struct {
+		name             string
+		inputWorkDir     string
+		inputDisplayPath string
+		wantSkipFile     bool
+	}{
+		{
+			name:             "skip the file in the excluded directory from the config directory",
+			inputWorkDir:     dirPath,
+			inputDisplayPath: filepath.Join("third_party", "author.proto"),
+			wantSkipFile:     true,
+		},
+		{
+			name:             "skip the file in the excluded directory from a subdirectory",
+			inputWorkDir:     filepath.Join(dirPath, "proto"),
+			inputDisplayPath: filepath.Join("..", "third_party", "author.proto"),
+			wantSkipFile:     true,
+		},
+		{
+			name:             "skip the excluded file from a subdirectory",
+			inputWorkDir:     filepath.Join(dirPath, "proto"),
+			inputDisplayPath: filepath.Join("generated", "book.proto"),
+			wantSkipFile:     true,
+		},
+		{
+			name:             "skip the file in the excluded directory given the absolute path",
+			inputWorkDir:     wd,
+			inputDisplayPath: filepath.Join(dirPath, "third_party", "author.proto"),
+			wantSkipFile:     true,
+		},
+		{
+			name:             "not skip the file from a subdirectory",
+			inputWorkDir:     filepath.Join(dirPath, "proto"),
+			inputDisplayPath: "book.proto",
+		},
+		{
+			name:             "not skip the file matching the pattern only relative to the working directory",
+			inputWorkDir:     filepath.Join(dirPath, "proto"),
+			inputDisplayPath: filepath.Join("third_party", "book.proto"),
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if err := os.Chdir(test.inputWorkDir); err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			got := externalConfig.ShouldSkipFile(test.inputDisplayPath)
+			if got != test.wantSkipFile {
+				t.Errorf("got %v, but want %v", got, test.wantSkipFile)
+			}
+		})
+	}
+}
+
+func TestExternalConfig_Resolve(t *testing.T) {
+	inputConfig := []byte(`
+lint:
+  rules:
+    add:
+      - FIELDS_HAVE_COMMENT
+    remove:
+      - ENUMS_HAVE_COMMENT
+  rules_option:
+    max_line_length:
+      max_chars: 80
+      tab_chars: 2
+    indent:
+      style: tab
+    repeated_field_names_pluralized:
+      irregular_rules:
+        Irregular: Regular
+  overrides:
+    - files:
+        - v1/**
+      rules:
+        add:
+          - ENUMS_HAVE_COMMENT
+        remove:
+          - FIELDS_HAVE_COMMENT
+      rules_option:
+        max_line_length:
+          max_chars: 120
+        indent:
+          newline: "\r\n"
+        repeated_field_names_pluralized:
+          irregular_rules:
+            Person: People
+    - files:
+        - v1/legacy/*.proto
+      rules_option:
+        indent:
+          style: 4
+`)
+
+	var base config.ExternalConfig
+	if err := yaml.UnmarshalStrict(inputConfig, &base); err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name             string
+		inputDisplayPath string
+		wantRules        config.Rules
+		wantRulesOption  config.RulesOption
+	}{
+		{
+			name:             "no matching overrides",
+			inputDisplayPath: "v2/foo.proto",
+			wantRules: config.Rules{
+				Add:    []string{"FIELDS_HAVE_COMMENT"},
+				Remove: []string{"ENUMS_HAVE_COMMENT"},
+			},
+			wantRulesOption: config.RulesOption{
+				MaxLineLength: config.MaxLineLengthOption{
+					MaxChars: 80,
+					TabChars: 2,
+				},
+				Indent: config.IndentOption{
+					Style: "\t",
+				},
+				RepeatedFieldNamesPluralized: config.RepeatedFieldNamesPluralizedOption{
+					IrregularRules: map[string]string{
+						"Irregular": "Regular",
+					},
+				},
+			},
+		},
+		{
+			name:             "a matching override",
+			inputDisplayPath: "v1/foo.proto",
+			wantRules: config.Rules{
+				Add:    []string{"ENUMS_HAVE_COMMENT"},
+				Remove: []string{"FIELDS_HAVE_COMMENT"},
+			},
+			wantRulesOption: config.RulesOption{
+				MaxLineLength: config.MaxLineLengthOption{
+					MaxChars: 120,
+					TabChars: 2,
+				},
+				Indent: config.IndentOption{
+					Style:   "\t",
+					Newline: "\r\n",
+				},
+				RepeatedFieldNamesPluralized: config.RepeatedFieldNamesPluralizedOption{
+					IrregularRules: map[string]string{
+						"Irregular": "Regular",
+						"Person":    "People",
+					},
+				},
+			},
+		},
+		{
+			name:             "matching overrides are applied in order",
+			inputDisplayPath: "v1/legacy/foo.proto",
+			wantRules: config.Rules{
+				Add:    []string{"ENUMS_HAVE_COMMENT"},
+				Remove: []string{"FIELDS_HAVE_COMMENT"},
+			},
+			wantRulesOption: config.RulesOption{
+				MaxLineLength: config.MaxLineLengthOption{
+					MaxChars: 120,
+					TabChars: 2,
+				},
+				Indent: config.IndentOption{
+					Style:   strings.Repeat(" ", 4),
+					Newline: "\r\n",
+				},
+				RepeatedFieldNamesPluralized: config.RepeatedFieldNamesPluralizedOption{
+					IrregularRules: map[string]string{
+						"Irregular": "Regular",
+						"Person":    "People",
+					},
+				},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := base.Resolve(test.inputDisplayPath)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got.Lint.Rules, test.wantRules) {
+				t.Errorf("got %v, but want %v", got.Lint.Rules, test.wantRules)
+			}
+			if !reflect.DeepEqual(got.Lint.RulesOption, test.wantRulesOption) {
+				t.Errorf("got %v, but want %v", got.Lint.RulesOption, test.wantRulesOption)
+			}
+			if len(got.Lint.Overrides) != 0 {
+				t.Errorf("got %v, but want no overrides", got.Lint.Overrides)
+			}
+		})
+	}
+}
+
+func TestOverrideRulesOption_UnmarshalYAML(t *testing.T) {
+	var got config.Override
+	err := yaml.UnmarshalStrict([]byte(`
+files:
+  - v1/**
+rules_option:
+  max_line_length:
+    max_char: 120
+`), &got)
+	if err == nil {
+		t.Errorf("got err nil, but want err")
+	}
+}
+
+func TestExternalConfig_ValidateRulesOption(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		inputConfig string
+		wantErr     string
+	}{
+		{
+			name: "no error for the valid values",
+			inputConfig: `
+lint:
+  rules_option:
+    proto2_constructs_avoid:
+      constructs:
+        - required
+`,
+		},
+		{
+			name: "an error for the unknown construct",
+			inputConfig: `
+lint:
+  rules_option:
+    proto2_constructs_avoid:
+      constructs:
+        - required
+        - optional
+`,
+			wantErr: "invalid rules_option.proto2_constructs_avoid.constructs[1]: optional is an invalid constructs option. valid option is required, group, extensions or default",
+		},
+		{
+			name: "an error for the invalid pattern of the override",
+			inputConfig: `
+lint:
+  overrides:
+    - files:
+        - legacy/**
+      rules_option:
+        comments_match_pattern:
+          pattern: "(unclosed"
+`,
+			wantErr: "invalid rules_option.comments_match_pattern.pattern: (unclosed is an invalid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var c config.ExternalConfig
+			if err := yaml.UnmarshalStrict([]byte(test.inputConfig), &c); err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			err := c.ValidateRulesOption(subcmds.RulesOptionDocs())
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("got err %v, but want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.wantErr {
+				t.Errorf("got err %v, but want %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package config
+
+// FieldsAvoidDiscouragedTypesOption represents the option for the FIELDS_AVOID_DISCOURAGED_TYPES rule.
+type FieldsAvoidDiscouragedTypesOption struct {
+	Types         []string `yaml:"types"`
+	AllowedFields []string `yaml:"allowed_fields"`
+}
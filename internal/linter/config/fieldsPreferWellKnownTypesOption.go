@@ -0,0 +1,9 @@
+package config
+
+// FieldsPreferWellKnownTypesOption represents the option for the FIELDS_PREFER_WELL_KNOWN_TYPES rule.
+type FieldsPreferWellKnownTypesOption struct {
+	TimestampSuffixes []string `yaml:"timestamp_suffixes"`
+	DurationSuffixes  []string `yaml:"duration_suffixes"`
+	UnitSuffixes      []string `yaml:"unit_suffixes"`
+	FieldMaskSuffixes []string `yaml:"field_mask_suffixes"`
+}
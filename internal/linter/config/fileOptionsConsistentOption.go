@@ -0,0 +1,6 @@
+package config
+
+// FileOptionsConsistentOption represents the option for the FILE_OPTIONS_CONSISTENT rule.
+type FileOptionsConsistentOption struct {
+	Options []string `yaml:"options"`
+}
@@ -0,0 +1,6 @@
+package config
+
+// FileOptionsMatchTemplateOption represents the option for the FILE_OPTIONS_MATCH_TEMPLATE rule.
+type FileOptionsMatchTemplateOption struct {
+	Templates map[string]string `yaml:"templates"`
+}
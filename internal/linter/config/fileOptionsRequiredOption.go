@@ -0,0 +1,6 @@
+package config
+
+// FileOptionsRequiredOption represents the option for the FILE_OPTIONS_REQUIRED rule.
+type FileOptionsRequiredOption struct {
+	Options []string `yaml:"options"`
+}
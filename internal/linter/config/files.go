@@ -1,17 +1,29 @@
 package config
 
+import "github.com/tyhal/protolint/internal/pathutil"
+
+var ignoreFileNames = []string{
+	".gitignore",
+	".protolintignore",
+}
+
 // Files represents the target files.
 type Files struct {
 	Exclude []string `yaml:"exclude"`
+	// RespectIgnoreFiles decides whether to exclude the files listed in .gitignore and .protolintignore.
+	// They are tied because .protolintignore only adds the files protolint should skip on top of .gitignore.
+	RespectIgnoreFiles bool `yaml:"respect_ignore_files"`
 }
 
 func (d Files) shouldSkipRule(
 	displayPath string,
 ) bool {
-	for _, exclude := range d.Exclude {
-		if displayPath == exclude {
-			return true
-		}
+	return pathutil.Patterns(d.Exclude).Match(displayPath)
+}
+
+func (d Files) ignoreFileNames() []string {
+	if !d.RespectIgnoreFiles {
+		return nil
 	}
-	return false
+	return ignoreFileNames
 }
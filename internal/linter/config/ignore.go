@@ -1,6 +1,6 @@
 package config
 
-import "github.com/tyhal/protolint/internal/stringsutil"
+import "github.com/tyhal/protolint/internal/pathutil"
 
 // Ignore represents files ignoring the specific rule.
 type Ignore struct {
@@ -15,5 +15,5 @@ func (i Ignore) shouldSkipRule(
 	if i.ID != ruleID {
 		return false
 	}
-	return stringsutil.ContainsStringInSlice(displayPath, i.Files)
+	return pathutil.Patterns(i.Files).Match(displayPath)
 }
@@ -19,8 +19,10 @@ func (i *ImportsSortedOption) UnmarshalYAML(unmarshal func(interface{}) error) e
 	}
 
 	switch option.Newline {
-	case "\n", "\r", "\r\n", "":
+	case "\n", "\r", "\r\n":
 		i.Newline = option.Newline
+	case "":
+		break
 	default:
 		return fmt.Errorf(`%s is an invalid newline option. valid option is \n, \r or \r\n`, option.Newline)
 	}
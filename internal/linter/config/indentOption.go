@@ -21,26 +21,47 @@ func (i *IndentOption) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 
-	var style string
+	// Keep the current values for the absent options, so that it can be merged with an override.
 	switch option.Style {
 	case "tab":
-		style = "\t"
+		i.Style = "\t"
 	case "4":
-		style = strings.Repeat(" ", 4)
+		i.Style = strings.Repeat(" ", 4)
 	case "2":
-		style = strings.Repeat(" ", 2)
+		i.Style = strings.Repeat(" ", 2)
 	case "":
 		break
 	default:
 		return fmt.Errorf("%s is an invalid style option. valid option is tab, 4 or 2", option.Style)
 	}
-	i.Style = style
 
 	switch option.Newline {
-	case "\n", "\r", "\r\n", "":
+	case "\n", "\r", "\r\n":
 		i.Newline = option.Newline
+	case "":
+		break
 	default:
 		return fmt.Errorf(`%s is an invalid newline option. valid option is \n, \r or \r\n`, option.Newline)
 	}
 	return nil
 }
+
+// MarshalYAML implements yaml.v2 Marshaler interface.
+func (i IndentOption) MarshalYAML() (interface{}, error) {
+	var style string
+	switch i.Style {
+	case "\t":
+		style = "tab"
+	case strings.Repeat(" ", 4):
+		style = "4"
+	case strings.Repeat(" ", 2):
+		style = "2"
+	}
+	return struct {
+		Style   string `yaml:"style,omitempty"`
+		Newline string `yaml:"newline,omitempty"`
+	}{
+		Style:   style,
+		Newline: i.Newline,
+	}, nil
+}
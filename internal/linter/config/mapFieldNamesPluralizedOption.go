@@ -0,0 +1,9 @@
+package config
+
+// MapFieldNamesPluralizedOption represents the option for the MAP_FIELD_NAMES_PLURALIZED rule.
+type MapFieldNamesPluralizedOption struct {
+	PluralRules      map[string]string `yaml:"plural_rules"`
+	SingularRules    map[string]string `yaml:"singular_rules"`
+	UncountableRules []string          `yaml:"uncountable_rules"`
+	IrregularRules   map[string]string `yaml:"irregular_rules"`
+}
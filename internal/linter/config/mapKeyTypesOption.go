@@ -0,0 +1,6 @@
+package config
+
+// MapKeyTypesOption represents the option for the MAP_KEY_TYPES rule.
+type MapKeyTypesOption struct {
+	Types []string `yaml:"types"`
+}
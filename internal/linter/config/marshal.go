@@ -0,0 +1,58 @@
+package config
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// MarshalWithoutZero marshals the value into YAML, omitting the zero values like 0, "", false and empty collections.
+// It is useful to show only the configured values.
+func MarshalWithoutZero(v interface{}) ([]byte, error) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree yaml.MapSlice
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	pruned, _ := pruneZero(tree)
+	if pruned == nil {
+		return []byte("{}\n"), nil
+	}
+	return yaml.Marshal(pruned)
+}
+
+// pruneZero removes the zero values recursively. It returns false if the value itself is zero.
+func pruneZero(v interface{}) (interface{}, bool) {
+	switch value := v.(type) {
+	case nil:
+		return nil, false
+	case yaml.MapSlice:
+		var pruned yaml.MapSlice
+		for _, item := range value {
+			if p, ok := pruneZero(item.Value); ok {
+				pruned = append(pruned, yaml.MapItem{Key: item.Key, Value: p})
+			}
+		}
+		return pruned, 0 < len(pruned)
+	case []interface{}:
+		var pruned []interface{}
+		for _, item := range value {
+			if p, ok := pruneZero(item); ok {
+				pruned = append(pruned, p)
+			}
+		}
+		return pruned, 0 < len(pruned)
+	case string:
+		return value, value != ""
+	case int:
+		return value, value != 0
+	case float64:
+		return value, value != 0
+	case bool:
+		return value, value
+	default:
+		return value, true
+	}
+}
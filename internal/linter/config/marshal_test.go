@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/tyhal/protolint/internal/linter/config"
+)
+
+func TestMarshalWithoutZero(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		inputLint config.Lint
+		want      string
+	}{
+		{
+			name: "all zero",
+			want: "{}\n",
+		},
+		{
+			name: "omit zero values",
+			inputLint: config.Lint{
+				Rules: config.Rules{
+					NoDefault: true,
+					Add:       []string{"INDENT"},
+				},
+				RulesOption: config.RulesOption{
+					MaxLineLength: config.MaxLineLengthOption{
+						MaxChars: 120,
+					},
+					Indent: config.IndentOption{
+						Style: "\t",
+					},
+				},
+			},
+			want: `rules:
+  no_default: true
+  add:
+  - INDENT
+rules_option:
+  max_line_length:
+    max_chars: 120
+  indent:
+    style: tab
+`,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := config.MarshalWithoutZero(test.inputLint)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if string(got) != test.want {
+				t.Errorf("got %q, but want %q", got, test.want)
+			}
+		})
+	}
+}
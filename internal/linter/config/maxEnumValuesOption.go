@@ -0,0 +1,6 @@
+package config
+
+// MaxEnumValuesOption represents the option for the MAX_ENUM_VALUES rule.
+type MaxEnumValuesOption struct {
+	MaxValues int `yaml:"max_values"`
+}
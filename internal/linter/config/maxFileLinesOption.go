@@ -0,0 +1,6 @@
+package config
+
+// MaxFileLinesOption represents the option for the MAX_FILE_LINES rule.
+type MaxFileLinesOption struct {
+	MaxLines int `yaml:"max_lines"`
+}
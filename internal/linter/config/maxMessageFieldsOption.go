@@ -0,0 +1,6 @@
+package config
+
+// MaxMessageFieldsOption represents the option for the MAX_MESSAGE_FIELDS rule.
+type MaxMessageFieldsOption struct {
+	MaxFields int `yaml:"max_fields"`
+}
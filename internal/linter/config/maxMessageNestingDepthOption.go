@@ -0,0 +1,6 @@
+package config
+
+// MaxMessageNestingDepthOption represents the option for the MAX_MESSAGE_NESTING_DEPTH rule.
+type MaxMessageNestingDepthOption struct {
+	MaxDepth int `yaml:"max_depth"`
+}
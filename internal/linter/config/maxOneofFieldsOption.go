@@ -0,0 +1,6 @@
+package config
+
+// MaxOneofFieldsOption represents the option for the MAX_ONEOF_FIELDS rule.
+type MaxOneofFieldsOption struct {
+	MaxFields int `yaml:"max_fields"`
+}
@@ -0,0 +1,6 @@
+package config
+
+// MaxServiceRPCsOption represents the option for the MAX_SERVICE_RPCS rule.
+type MaxServiceRPCsOption struct {
+	MaxRPCs int `yaml:"max_rpcs"`
+}
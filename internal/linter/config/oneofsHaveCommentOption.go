@@ -0,0 +1,6 @@
+package config
+
+// OneofsHaveCommentOption represents the option for the ONEOFS_HAVE_COMMENT rule.
+type OneofsHaveCommentOption struct {
+	ShouldFollowGolangStyle bool `yaml:"should_follow_golang_style"`
+}
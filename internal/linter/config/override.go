@@ -0,0 +1,62 @@
+package config
+
+import (
+	"github.com/tyhal/protolint/internal/pathutil"
+	"github.com/tyhal/protolint/internal/stringsutil"
+)
+
+// Override represents the rules and the rules option applied to the specific files.
+type Override struct {
+	Files       []string            `yaml:"files"`
+	Rules       OverrideRules       `yaml:"rules"`
+	RulesOption OverrideRulesOption `yaml:"rules_option"`
+}
+
+// OverrideRules represents the rules added or removed for the specific files.
+type OverrideRules struct {
+	Add    []string `yaml:"add"`
+	Remove []string `yaml:"remove"`
+}
+
+func (o Override) match(
+	displayPath string,
+) bool {
+	return pathutil.Patterns(o.Files).Match(displayPath)
+}
+
+func (o Override) apply(
+	lint Lint,
+) (Lint, error) {
+	option, err := o.RulesOption.mergeInto(lint.RulesOption)
+	if err != nil {
+		return lint, err
+	}
+	lint.RulesOption = option
+	lint.Rules = o.Rules.mergeInto(lint.Rules)
+	return lint, nil
+}
+
+// mergeInto merges the rules, so that the rules added by the override take precedence over the removed ones and vice versa.
+func (r OverrideRules) mergeInto(
+	base Rules,
+) Rules {
+	var add []string
+	for _, id := range base.Add {
+		if !stringsutil.ContainsStringInSlice(id, r.Remove) {
+			add = append(add, id)
+		}
+	}
+	add = append(add, r.Add...)
+
+	var remove []string
+	for _, id := range base.Remove {
+		if !stringsutil.ContainsStringInSlice(id, r.Add) {
+			remove = append(remove, id)
+		}
+	}
+	remove = append(remove, r.Remove...)
+
+	base.Add = add
+	base.Remove = remove
+	return base
+}
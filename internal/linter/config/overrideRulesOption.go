@@ -0,0 +1,118 @@
+package config
+
+import (
+	"reflect"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// OverrideRulesOption represents the partial rules option for the specific files.
+// Only the options written in the config file override the base ones.
+type OverrideRulesOption struct {
+	raw []byte
+}
+
+// UnmarshalYAML implements yaml.v2 Unmarshaler interface.
+func (o *OverrideRulesOption) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// Validate the option in advance.
+	var rulesOption RulesOption
+	if err := unmarshal(&rulesOption); err != nil {
+		return err
+	}
+
+	var option yaml.MapSlice
+	if err := unmarshal(&option); err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(option)
+	if err != nil {
+		return err
+	}
+	o.raw = raw
+	return nil
+}
+
+// MarshalYAML implements yaml.v2 Marshaler interface.
+func (o OverrideRulesOption) MarshalYAML() (interface{}, error) {
+	var option yaml.MapSlice
+	if err := yaml.Unmarshal(o.raw, &option); err != nil {
+		return nil, err
+	}
+	return option, nil
+}
+
+// resolvePaths resolves the relative paths of the dictionaries in the partial option against the directory of the config file.
+func (o *OverrideRulesOption) resolvePaths(dirPath string) error {
+	if len(o.raw) == 0 {
+		return nil
+	}
+
+	var option yaml.MapSlice
+	if err := yaml.Unmarshal(o.raw, &option); err != nil {
+		return err
+	}
+	for _, item := range option {
+		if item.Key != "spelling" {
+			continue
+		}
+		spelling, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		for i, spellingItem := range spelling {
+			paths, ok := spellingItem.Value.([]interface{})
+			if spellingItem.Key != "dictionaries" || !ok {
+				continue
+			}
+			for j, path := range paths {
+				if path, ok := path.(string); ok {
+					paths[j] = resolvePath(dirPath, path)
+				}
+			}
+			spelling[i].Value = paths
+		}
+	}
+	raw, err := yaml.Marshal(option)
+	if err != nil {
+		return err
+	}
+	o.raw = raw
+	return nil
+}
+
+// mergeInto decodes the partial option on top of the base option.
+func (o OverrideRulesOption) mergeInto(
+	base RulesOption,
+) (RulesOption, error) {
+	if len(o.raw) == 0 {
+		return base, nil
+	}
+
+	// Decoding into a non-nil map adds the entries to the map shared with the base.
+	merged := base
+	cloneMaps(reflect.ValueOf(&merged).Elem())
+	if err := yaml.UnmarshalStrict(o.raw, &merged); err != nil {
+		return base, err
+	}
+	return merged, nil
+}
+
+func cloneMaps(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				cloneMaps(v.Field(i))
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		clone := reflect.MakeMap(v.Type())
+		for _, key := range v.MapKeys() {
+			clone.SetMapIndex(key, v.MapIndex(key))
+		}
+		v.Set(clone)
+	}
+}
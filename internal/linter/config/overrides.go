@@ -0,0 +1,22 @@
+package config
+
+// Overrides represents list about the overrides for the specific files.
+type Overrides []Override
+
+// apply applies all overrides matching the file in order.
+func (ors Overrides) apply(
+	lint Lint,
+	displayPath string,
+) (Lint, error) {
+	for _, o := range ors {
+		if !o.match(displayPath) {
+			continue
+		}
+		var err error
+		lint, err = o.apply(lint)
+		if err != nil {
+			return lint, err
+		}
+	}
+	return lint, nil
+}
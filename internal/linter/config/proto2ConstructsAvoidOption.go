@@ -0,0 +1,6 @@
+package config
+
+// Proto2ConstructsAvoidOption represents the option for the PROTO2_CONSTRUCTS_AVOID rule.
+type Proto2ConstructsAvoidOption struct {
+	Constructs []string `yaml:"constructs"`
+}
@@ -0,0 +1,9 @@
+package config
+
+// RPCListPaginationOption represents the option for the RPC_LIST_PAGINATION rule.
+type RPCListPaginationOption struct {
+	RPCs               []string `yaml:"rpcs"`
+	PageSizeField      string   `yaml:"page_size_field"`
+	PageTokenField     string   `yaml:"page_token_field"`
+	NextPageTokenField string   `yaml:"next_page_token_field"`
+}
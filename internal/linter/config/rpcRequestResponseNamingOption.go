@@ -0,0 +1,7 @@
+package config
+
+// RPCRequestResponseNamingOption represents the option for the RPC_REQUEST_RESPONSE_NAMING rule.
+type RPCRequestResponseNamingOption struct {
+	RequestSuffix  string `yaml:"request_suffix"`
+	ResponseSuffix string `yaml:"response_suffix"`
+}
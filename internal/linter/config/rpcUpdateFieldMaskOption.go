@@ -0,0 +1,7 @@
+package config
+
+// RPCUpdateFieldMaskOption represents the option for the RPC_UPDATE_FIELD_MASK rule.
+type RPCUpdateFieldMaskOption struct {
+	RPCs            []string `yaml:"rpcs"`
+	UpdateMaskField string   `yaml:"update_mask_field"`
+}
@@ -0,0 +1,8 @@
+package config
+
+// RPCsAvoidStreamingOption represents the option for the RPCS_AVOID_STREAMING rule.
+type RPCsAvoidStreamingOption struct {
+	AllowedServices []string `yaml:"allowed_services"`
+	AllowedRPCs     []string `yaml:"allowed_rpcs"`
+	RequireComment  bool     `yaml:"require_comment"`
+}
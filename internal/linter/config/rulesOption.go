@@ -1,21 +1,64 @@
 package config
 
+import (
+	"reflect"
+	"strings"
+)
+
 // RulesOption represents the option for some rules.
 type RulesOption struct {
 	FileNamesLowerSnakeCase         FileNamesLowerSnakeCaseOption         `yaml:"file_names_lower_snake_case"`
 	ImportsSorted                   ImportsSortedOption                   `yaml:"imports_sorted"`
 	MaxLineLength                   MaxLineLengthOption                   `yaml:"max_line_length"`
+	MaxMessageFields                MaxMessageFieldsOption                `yaml:"max_message_fields"`
+	MaxMessageNestingDepth          MaxMessageNestingDepthOption          `yaml:"max_message_nesting_depth"`
+	MaxEnumValues                   MaxEnumValuesOption                   `yaml:"max_enum_values"`
+	MaxServiceRPCs                  MaxServiceRPCsOption                  `yaml:"max_service_rpcs"`
+	MaxOneofFields                  MaxOneofFieldsOption                  `yaml:"max_oneof_fields"`
+	MaxFileLines                    MaxFileLinesOption                    `yaml:"max_file_lines"`
 	Indent                          IndentOption                          `yaml:"indent"`
 	EnumFieldNamesZeroValueEndWith  EnumFieldNamesZeroValueEndWithOption  `yaml:"enum_field_names_zero_value_end_with"`
+	EnumFieldNamesPrefix            EnumFieldNamesPrefixOption            `yaml:"enum_field_names_prefix"`
+	EnumZeroValueNotSemantic        EnumZeroValueNotSemanticOption        `yaml:"enum_zero_value_not_semantic"`
 	ServiceNamesEndWith             ServiceNamesEndWithOption             `yaml:"service_names_end_with"`
 	FieldNamesExcludePrepositions   FieldNamesExcludePrepositionsOption   `yaml:"field_names_exclude_prepositions"`
 	MessageNamesExcludePrepositions MessageNamesExcludePrepositionsOption `yaml:"message_names_exclude_prepositions"`
 	MessagesHaveComment             MessagesHaveCommentOption             `yaml:"messages_have_comment"`
 	ServicesHaveComment             ServicesHaveCommentOption             `yaml:"services_have_comment"`
 	RPCsHaveComment                 RPCsHaveCommentOption                 `yaml:"rpcs_have_comment"`
+	RPCRequestResponseNaming        RPCRequestResponseNamingOption        `yaml:"rpc_request_response_naming"`
+	RPCsAvoidStreaming              RPCsAvoidStreamingOption              `yaml:"rpcs_avoid_streaming"`
+	RPCListPagination               RPCListPaginationOption               `yaml:"rpc_list_pagination"`
+	RPCUpdateFieldMask              RPCUpdateFieldMaskOption              `yaml:"rpc_update_field_mask"`
 	FieldsHaveComment               FieldsHaveCommentOption               `yaml:"fields_have_comment"`
 	EnumsHaveComment                EnumsHaveCommentOption                `yaml:"enums_have_comment"`
 	EnumFieldsHaveComment           EnumFieldsHaveCommentOption           `yaml:"enum_fields_have_comment"`
+	CommentsMinWords                CommentsMinWordsOption                `yaml:"comments_min_words"`
+	CommentsTodoReference           CommentsTodoReferenceOption           `yaml:"comments_todo_reference"`
+	CommentsMatchPattern            CommentsMatchPatternOption            `yaml:"comments_match_pattern"`
+	Spelling                        SpellingOption                        `yaml:"spelling"`
 	SyntaxConsistent                SyntaxConsistentOption                `yaml:"syntax_consistent"`
+	EditionsFeatures                EditionsFeaturesOption                `yaml:"editions_features"`
+	Proto2ConstructsAvoid           Proto2ConstructsAvoidOption           `yaml:"proto2_constructs_avoid"`
 	RepeatedFieldNamesPluralized    RepeatedFieldNamesPluralizedOption    `yaml:"repeated_field_names_pluralized"`
+	MapKeyTypes                     MapKeyTypesOption                     `yaml:"map_key_types"`
+	MapFieldNamesPluralized         MapFieldNamesPluralizedOption         `yaml:"map_field_names_pluralized"`
+	OneofsHaveComment               OneofsHaveCommentOption               `yaml:"oneofs_have_comment"`
+	DeprecatedElementsHaveComment   DeprecatedElementsHaveCommentOption   `yaml:"deprecated_elements_have_comment"`
+	DeletedFieldsUseReserved        DeletedFieldsUseReservedOption        `yaml:"deleted_fields_use_reserved"`
+	FieldsPreferWellKnownTypes      FieldsPreferWellKnownTypesOption      `yaml:"fields_prefer_well_known_types"`
+	FieldsAvoidDiscouragedTypes     FieldsAvoidDiscouragedTypesOption     `yaml:"fields_avoid_discouraged_types"`
+	FileOptionsRequired             FileOptionsRequiredOption             `yaml:"file_options_required"`
+	FileOptionsConsistent           FileOptionsConsistentOption           `yaml:"file_options_consistent"`
+	FileOptionsMatchTemplate        FileOptionsMatchTemplateOption        `yaml:"file_options_match_template"`
+}
+
+// Lookup returns the option for the rule. The key of the option is the lower case of the rule ID.
+func (r RulesOption) Lookup(ruleID string) (interface{}, bool) {
+	v := reflect.ValueOf(r)
+	field, ok := fieldByYAMLKey(v.Type(), strings.ToLower(ruleID))
+	if !ok {
+		return nil, false
+	}
+	return v.FieldByIndex(field.Index).Interface(), true
 }
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/rule"
+)
+
+// rulesOptionValueError represents an invalid value of the rules option.
+type rulesOptionValueError struct {
+	// keyPath is the path of the value from the rules option, e.g. comments_match_pattern, pattern.
+	keyPath []interface{}
+	message string
+}
+
+// checkRulesOptionValues verifies the values of the rules option.
+// The numbers must not be negative, and the other values follow the kinds and the valid values of the option docs keyed by the rule IDs.
+func checkRulesOptionValues(
+	option RulesOption,
+	optionDocs map[string][]rule.OptionDoc,
+) []rulesOptionValueError {
+	var errs []rulesOptionValueError
+	addErrorf := func(keyPath []interface{}, format string, a ...interface{}) {
+		errs = append(errs, rulesOptionValueError{
+			keyPath: keyPath,
+			message: fmt.Sprintf(format, a...),
+		})
+	}
+
+	value := reflect.ValueOf(option)
+	for i := 0; i < value.NumField(); i++ {
+		ruleOption := value.Field(i)
+		if ruleOption.Kind() != reflect.Struct {
+			continue
+		}
+		ruleKey := YAMLKey(value.Type().Field(i))
+		// The option key is the lower case of the rule ID.
+		docs := optionDocs[strings.ToUpper(ruleKey)]
+
+		for j := 0; j < ruleOption.NumField(); j++ {
+			key := YAMLKey(ruleOption.Type().Field(j))
+			field := ruleOption.Field(j)
+
+			// The numbers of the options are the limits and the counts.
+			if field.Kind() == reflect.Int {
+				if n := field.Int(); n < 0 {
+					addErrorf([]interface{}{ruleKey, key}, "%s must not be negative, but was %d", key, n)
+				}
+				continue
+			}
+
+			doc, ok := findOptionDoc(docs, key)
+			if !ok {
+				continue
+			}
+			for _, v := range optionStrings(field, ruleKey, key) {
+				if err := checkOptionValue(doc, v.value); err != "" {
+					addErrorf(v.keyPath, "%s", err)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+type optionString struct {
+	keyPath []interface{}
+	value   string
+}
+
+// optionStrings returns the string value, or the elements of the string slice with their key paths.
+func optionStrings(
+	field reflect.Value,
+	ruleKey string,
+	key string,
+) []optionString {
+	switch {
+	case field.Kind() == reflect.String && field.String() != "":
+		return []optionString{
+			{keyPath: []interface{}{ruleKey, key}, value: field.String()},
+		}
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+		var vs []optionString
+		for i := 0; i < field.Len(); i++ {
+			vs = append(vs, optionString{
+				keyPath: []interface{}{ruleKey, key, i},
+				value:   field.Index(i).String(),
+			})
+		}
+		return vs
+	}
+	return nil
+}
+
+// checkOptionValue returns the message of the problem of the value, or the empty string if it is valid.
+func checkOptionValue(
+	doc rule.OptionDoc,
+	value string,
+) string {
+	switch doc.Kind {
+	case rule.OptionKindRegexp:
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Sprintf("%s is an invalid regular expression: %s", value, err)
+		}
+	case rule.OptionKindGlob:
+		if _, err := path.Match(value, ""); err != nil {
+			return fmt.Sprintf("%s is an invalid pattern: %s", value, err)
+		}
+	}
+	if 0 < len(doc.Values) && !stringsutil.ContainsStringInSlice(value, doc.Values) {
+		return fmt.Sprintf("%s is an invalid %s option. valid option is %s", value, doc.Name, joinOr(doc.Values))
+	}
+	return ""
+}
+
+func findOptionDoc(
+	docs []rule.OptionDoc,
+	key string,
+) (rule.OptionDoc, bool) {
+	for _, doc := range docs {
+		if doc.Name == key {
+			return doc, true
+		}
+	}
+	return rule.OptionDoc{}, false
+}
+
+// joinOr returns the values joined like a, b or c.
+func joinOr(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
+
+// ValidateRulesOption verifies the values of the rules option and the ones of the overrides,
+// so that an invalid value fails once before linting rather than for each file.
+// optionDocs is the docs of the rule options keyed by the rule IDs.
+func (c ExternalConfig) ValidateRulesOption(
+	optionDocs map[string][]rule.OptionDoc,
+) error {
+	options := []RulesOption{c.Lint.RulesOption}
+	for _, override := range c.Lint.Overrides {
+		option, err := override.RulesOption.mergeInto(c.Lint.RulesOption)
+		if err != nil {
+			return err
+		}
+		options = append(options, option)
+	}
+
+	for _, option := range options {
+		if errs := checkRulesOptionValues(option, optionDocs); 0 < len(errs) {
+			return fmt.Errorf("invalid rules_option.%s: %s", joinKeyPath(errs[0].keyPath), errs[0].message)
+		}
+	}
+	return nil
+}
+
+// joinKeyPath returns the key path joined like comments_match_pattern.pattern or rpcs_avoid_streaming.allowed_rpcs[1].
+func joinKeyPath(keyPath []interface{}) string {
+	var b strings.Builder
+	for _, key := range keyPath {
+		switch key := key.(type) {
+		case int:
+			_, _ = fmt.Fprintf(&b, "[%d]", key)
+		default:
+			if 0 < b.Len() {
+				b.WriteString(".")
+			}
+			_, _ = fmt.Fprintf(&b, "%v", key)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package config_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/linter/config"
+)
+
+func TestRulesOption_Lookup(t *testing.T) {
+	option := config.RulesOption{
+		MaxLineLength: config.MaxLineLengthOption{
+			MaxChars: 100,
+		},
+	}
+
+	for _, test := range []struct {
+		name       string
+		ruleID     string
+		wantOption interface{}
+		wantOK     bool
+	}{
+		{
+			name:   "the rule with the option",
+			ruleID: "MAX_LINE_LENGTH",
+			wantOption: config.MaxLineLengthOption{
+				MaxChars: 100,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "the rule without the option",
+			ruleID: "ORDER",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := option.Lookup(test.ruleID)
+			if ok != test.wantOK {
+				t.Errorf("got %v, but want %v", ok, test.wantOK)
+			}
+			if !reflect.DeepEqual(got, test.wantOption) {
+				t.Errorf("got %v, but want %v", got, test.wantOption)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import "path/filepath"
+
+// SpellingOption represents the option for the SPELLING rule.
+type SpellingOption struct {
+	Words        []string `yaml:"words"`
+	Dictionaries []string `yaml:"dictionaries"`
+}
+
+// resolvePaths resolves the relative paths of the dictionaries against the directory of the config file.
+func (o SpellingOption) resolvePaths(dirPath string) SpellingOption {
+	if len(o.Dictionaries) == 0 {
+		return o
+	}
+	dictionaries := make([]string, len(o.Dictionaries))
+	for i, path := range o.Dictionaries {
+		dictionaries[i] = resolvePath(dirPath, path)
+	}
+	o.Dictionaries = dictionaries
+	return o
+}
+
+func resolvePath(
+	dirPath string,
+	path string,
+) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dirPath, path)
+}
@@ -1,5 +1,12 @@
 package config
 
+import "regexp"
+
+// SyntaxVersionPattern matches the valid versions of the SYNTAX_CONSISTENT rule, proto2, proto3 or an edition like "2023".
+const SyntaxVersionPattern = `^(proto2|proto3|[0-9]+)$`
+
+var syntaxVersionRegexp = regexp.MustCompile(SyntaxVersionPattern)
+
 // SyntaxConsistentOption represents the option for the SYNTAX_CONSISTENT rule.
 type SyntaxConsistentOption struct {
 	Version string `yaml:"version"`
@@ -0,0 +1,325 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/tyhal/protolint/internal/pathutil"
+	"github.com/tyhal/protolint/internal/stringsutil"
+	"github.com/tyhal/protolint/linter/rule"
+)
+
+var (
+	typeErrorLinePattern   = regexp.MustCompile(`^line (\d+): (.*)$`)
+	syntaxErrorLinePattern = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+)
+
+// ValidationError represents an invalid element in the config file.
+type ValidationError struct {
+	Pos     meta.Position
+	Message string
+}
+
+// Error implements error.
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("[%s] %s", e.Pos, e.Message)
+}
+
+// ValidateExternalConfig validates the external config file.
+//
+// ruleIDs is the IDs of all available rules, including the ones provided by plugins.
+// optionDocs is the docs of the rule options keyed by the rule IDs, which decide how the option values are verified.
+// displayPaths is the paths of the target files, which are used to find dead ignore entries.
+// It skips finding them if displayPaths is empty.
+func ValidateExternalConfig(
+	filePath string,
+	dirPath string,
+	ruleIDs []string,
+	optionDocs map[string][]rule.OptionDoc,
+	displayPaths []string,
+) (string, []ValidationError, error) {
+	filePath, err := getExternalConfigPath(filePath, dirPath)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(filePath) == 0 {
+		return "", nil, fmt.Errorf("not found a config file. Use -config_path or -config_dir_path")
+	}
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	node, err := newConfigNode(filePath, data)
+	if err != nil {
+		return filePath, []ValidationError{syntaxValidationError(filePath, err)}, nil
+	}
+	dirPath, err = filepath.Abs(filepath.Dir(filePath))
+	if err != nil {
+		return "", nil, err
+	}
+	v := configValidator{
+		node:         node,
+		dirPath:      dirPath,
+		ruleIDs:      ruleIDs,
+		optionDocs:   optionDocs,
+		displayPaths: displayPaths,
+	}
+
+	var config ExternalConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return filePath, v.decodeErrors(err), nil
+	}
+	return filePath, v.validate(config), nil
+}
+
+func syntaxValidationError(
+	filePath string,
+	err error,
+) ValidationError {
+	pos := meta.Position{
+		Filename: filePath,
+		Line:     1,
+		Column:   1,
+	}
+	message := err.Error()
+	if m := syntaxErrorLinePattern.FindStringSubmatch(message); m != nil {
+		pos.Line, _ = strconv.Atoi(m[1])
+		message = m[2]
+	}
+	return ValidationError{
+		Pos:     pos,
+		Message: message,
+	}
+}
+
+type configValidator struct {
+	node         configNode
+	ruleIDs      []string
+	optionDocs   map[string][]rule.OptionDoc
+	displayPaths []string
+	// The absolute path of the directory of the config file, which the patterns are relative to.
+	dirPath string
+	errs    []ValidationError
+}
+
+func (v *configValidator) addErrorf(
+	pos meta.Position,
+	format string,
+	a ...interface{},
+) {
+	v.errs = append(v.errs, ValidationError{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (v *configValidator) sortedErrors() []ValidationError {
+	sort.SliceStable(v.errs, func(i, j int) bool {
+		pi, pj := v.errs[i].Pos, v.errs[j].Pos
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+		return pi.Column < pj.Column
+	})
+	return v.errs
+}
+
+// decodeErrors converts the error returned by decoding the config.
+func (v *configValidator) decodeErrors(err error) []ValidationError {
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		for _, message := range typeErr.Errors {
+			pos := v.node.pos()
+			if m := typeErrorLinePattern.FindStringSubmatch(message); m != nil {
+				pos.Line, _ = strconv.Atoi(m[1])
+				pos.Column = 1
+				message = m[2]
+			}
+			v.addErrorf(pos, "%s", message)
+		}
+		return v.sortedErrors()
+	}
+
+	// The error returned by an option's UnmarshalYAML does not have the position.
+	v.validateRulesOptionValues("lint", "rules_option")
+	for i := 0; i < v.sequenceLen("lint", "overrides"); i++ {
+		v.validateRulesOptionValues("lint", "overrides", i, "rules_option")
+	}
+	if len(v.errs) == 0 {
+		v.addErrorf(v.node.pos(), "%s", err)
+	}
+	return v.sortedErrors()
+}
+
+// validateRulesOptionValues decodes each rule option separately to find the invalid one.
+func (v *configValidator) validateRulesOptionValues(path ...interface{}) {
+	optionType := reflect.TypeOf(RulesOption{})
+	for _, key := range v.node.mappingKeys(path...) {
+		field, ok := fieldByYAMLKey(optionType, key)
+		if !ok {
+			continue
+		}
+		keyPath := append(append([]interface{}{}, path...), key)
+		data, err := v.node.encode(keyPath...)
+		if err != nil {
+			continue
+		}
+		option := reflect.New(field.Type)
+		if err := yaml.UnmarshalStrict(data, option.Interface()); err != nil {
+			v.addErrorf(v.node.keyPos(keyPath...), "Invalid %s: %s", key, err)
+		}
+	}
+}
+
+func (v *configValidator) sequenceLen(path ...interface{}) int {
+	node := v.node.lookup(path...)
+	if node == nil {
+		return 0
+	}
+	return len(node.Content)
+}
+
+func (v *configValidator) validate(config ExternalConfig) []ValidationError {
+	lint := config.Lint
+
+	v.validateRuleIDs(lint.Rules.Add, "lint", "rules", "add")
+	v.validateRuleIDs(lint.Rules.Remove, "lint", "rules", "remove")
+	for i, ignore := range lint.Ignores {
+		v.validateRuleID(ignore.ID, "lint", "ignores", i, "id")
+	}
+	for i, override := range lint.Overrides {
+		v.validateRuleIDs(override.Rules.Add, "lint", "overrides", i, "rules", "add")
+		v.validateRuleIDs(override.Rules.Remove, "lint", "overrides", i, "rules", "remove")
+	}
+
+	v.validateRulesOption(lint.RulesOption, "lint", "rules_option")
+	for i, override := range lint.Overrides {
+		option, err := override.RulesOption.mergeInto(RulesOption{})
+		if err != nil {
+			v.addErrorf(v.node.pos("lint", "overrides", i, "rules_option"), "%s", err)
+			continue
+		}
+		v.validateRulesOption(option, "lint", "overrides", i, "rules_option")
+	}
+
+	if 0 < len(v.displayPaths) {
+		v.validateDeadEntries(lint)
+	}
+	return v.sortedErrors()
+}
+
+func (v *configValidator) validateRuleIDs(
+	ids []string,
+	path ...interface{},
+) {
+	for i, id := range ids {
+		v.validateRuleID(id, append(append([]interface{}{}, path...), i)...)
+	}
+}
+
+func (v *configValidator) validateRuleID(
+	id string,
+	path ...interface{},
+) {
+	if stringsutil.ContainsStringInSlice(id, v.ruleIDs) {
+		return
+	}
+	v.addErrorf(v.node.pos(path...), "Unknown rule ID %q. Run `protolint list` to see all available rules.", id)
+}
+
+func (v *configValidator) validateRulesOption(
+	option RulesOption,
+	keyPath ...interface{},
+) {
+	optionPath := func(elem ...interface{}) []interface{} {
+		return append(append([]interface{}{}, keyPath...), elem...)
+	}
+
+	for _, err := range checkRulesOptionValues(option, v.optionDocs) {
+		v.addErrorf(v.node.pos(optionPath(err.keyPath...)...), "%s", err.message)
+	}
+
+	if version := option.SyntaxConsistent.Version; version != "" && !syntaxVersionRegexp.MatchString(version) {
+		v.addErrorf(v.node.pos(optionPath("syntax_consistent", "version")...), "%s is an invalid version option. valid option is proto2, proto3 or an edition like 2023", version)
+	}
+}
+
+// validateDeadEntries finds the patterns in ignores, files.exclude, directories.exclude and overrides which match no target files.
+// The negated patterns are not dead since they only re-include the paths.
+func (v *configValidator) validateDeadEntries(lint Lint) {
+	for i, ignore := range lint.Ignores {
+		for j, pattern := range ignore.Files {
+			if !v.matchAny(pattern, false) {
+				v.addErrorf(v.node.pos("lint", "ignores", i, "files", j), "Dead ignore entry: %q for %s matches no target files.", pattern, ignore.ID)
+			}
+		}
+	}
+	for i, pattern := range lint.Files.Exclude {
+		if !v.matchAny(pattern, false) {
+			v.addErrorf(v.node.pos("lint", "files", "exclude", i), "Dead exclude entry: %q matches no target files.", pattern)
+		}
+	}
+	for i, pattern := range lint.Directories.Exclude {
+		if !v.matchAny(pattern, true) {
+			v.addErrorf(v.node.pos("lint", "directories", "exclude", i), "Dead exclude entry: %q matches no directories of the target files.", pattern)
+		}
+	}
+	for i, override := range lint.Overrides {
+		for j, pattern := range override.Files {
+			if !v.matchAny(pattern, false) {
+				v.addErrorf(v.node.pos("lint", "overrides", i, "files", j), "Dead override entry: %q matches no target files.", pattern)
+			}
+		}
+	}
+}
+
+// matchAny decides whether or not the pattern matches any target file, or any parent directory of them if dir is true.
+// A negated pattern always matches.
+func (v *configValidator) matchAny(
+	pattern string,
+	dir bool,
+) bool {
+	if strings.HasPrefix(pattern, "!") {
+		return true
+	}
+	patterns := pathutil.Patterns{pattern}
+	for _, displayPath := range v.displayPaths {
+		name := relPath(v.dirPath, displayPath)
+		if dir && patterns.MatchDir(name) || !dir && patterns.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldByYAMLKey(
+	t reflect.Type,
+	key string,
+) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if YAMLKey(field) == key {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// YAMLKey returns the key of the struct field following the rule of yaml.v2.
+func YAMLKey(field reflect.StructField) string {
+	tag := strings.Split(field.Tag.Get("yaml"), ",")[0]
+	if tag != "" {
+		return tag
+	}
+	return strings.ToLower(field.Name)
+}
@@ -0,0 +1,261 @@
+package config_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/cmd/subcmds"
+	"github.com/tyhal/protolint/internal/linter/config"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+func TestValidateExternalConfig(t *testing.T) {
+	problematicPath := setting_test.TestDataPath("problematicconfig", "protolint.yaml")
+	invalidPath := setting_test.TestDataPath("invalidconfig", "protolint.yaml")
+	ruleIDs := []string{
+		"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+		"ENUM_NAMES_UPPER_CAMEL_CASE",
+		"FIELD_NAMES_LOWER_SNAKE_CASE",
+		"FIELDS_HAVE_COMMENT",
+		"MAX_LINE_LENGTH",
+		"MESSAGE_NAMES_UPPER_CAMEL_CASE",
+		"RPC_NAMES_UPPER_CAMEL_CASE",
+	}
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, false, nil)
+	if err != nil {
+		t.Errorf("got err %v, but want nil", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name              string
+		inputFilePath     string
+		inputDisplayPaths []string
+		wantErrs          []config.ValidationError
+		wantExistErr      bool
+	}{
+		{
+			name:         "not found a config file",
+			wantExistErr: true,
+		},
+		{
+			name:          "no problems",
+			inputFilePath: setting_test.TestDataPath("validconfig", "protolint.yaml"),
+		},
+		{
+			name:          "invalid option values",
+			inputFilePath: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+			wantErrs: []config.ValidationError{
+				{
+					Pos: meta.Position{
+						Filename: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+						Line:     4,
+						Column:   19,
+					},
+					Message: `max_fields must not be negative, but was -5`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+						Line:     6,
+						Column:   16,
+					},
+					Message: "(unclosed is an invalid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+				},
+				{
+					Pos: meta.Position{
+						Filename: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+						Line:     10,
+						Column:   11,
+					},
+					Message: `[Stream is an invalid pattern: syntax error in pattern`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+						Line:     14,
+						Column:   11,
+					},
+					Message: `optional is an invalid constructs option. valid option is required, group, extensions or default`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: setting_test.TestDataPath("invalidoptionconfig", "protolint.yaml"),
+						Line:     18,
+						Column:   11,
+					},
+					Message: `float is an invalid types option. valid option is string, bool, int32, int64, uint32, uint64, sint32, sint64, fixed32, fixed64, sfixed32 or sfixed64`,
+				},
+			},
+		},
+		{
+			name:          "invalid yaml",
+			inputFilePath: invalidPath,
+			wantErrs: []config.ValidationError{
+				{
+					Pos: meta.Position{
+						Filename: invalidPath,
+						Line:     1,
+						Column:   1,
+					},
+					Message: "cannot unmarshal !!str `hogehoge` into config.ExternalConfig",
+				},
+			},
+		},
+		{
+			name:          "problems without target files",
+			inputFilePath: problematicPath,
+			wantErrs: []config.ValidationError{
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     3,
+						Column:   11,
+					},
+					Message: `Unknown rule ID "UNKNOWN_RULE". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     13,
+						Column:   9,
+					},
+					Message: `Unknown rule ID "FIELDS_HAVE_COMMENTS". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     16,
+						Column:   18,
+					},
+					Message: `max_chars must not be negative, but was -1`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     22,
+						Column:   13,
+					},
+					Message: `Unknown rule ID "UNKNOWN_RULE2". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     25,
+						Column:   20,
+					},
+					Message: `proto4 is an invalid version option. valid option is proto2, proto3 or an edition like 2023`,
+				},
+			},
+		},
+		{
+			name:          "problems with target files",
+			inputFilePath: problematicPath,
+			inputDisplayPaths: []string{
+				// The patterns are matched against the path relative to the config file.
+				filepath.Join(filepath.Dir(problematicPath), "path", "to", "foo.proto"),
+			},
+			wantErrs: []config.ValidationError{
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     3,
+						Column:   11,
+					},
+					Message: `Unknown rule ID "UNKNOWN_RULE". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     9,
+						Column:   11,
+					},
+					Message: `Dead ignore entry: "path/to/dead.proto" for MAX_LINE_LENGTH matches no target files.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     13,
+						Column:   9,
+					},
+					Message: `Unknown rule ID "FIELDS_HAVE_COMMENTS". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     16,
+						Column:   18,
+					},
+					Message: `max_chars must not be negative, but was -1`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     22,
+						Column:   13,
+					},
+					Message: `Unknown rule ID "UNKNOWN_RULE2". Run ` + "`protolint list`" + ` to see all available rules.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     25,
+						Column:   20,
+					},
+					Message: `proto4 is an invalid version option. valid option is proto2, proto3 or an edition like 2023`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     27,
+						Column:   11,
+					},
+					Message: `Dead override entry: "path/to/dead/**" matches no target files.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     30,
+						Column:   9,
+					},
+					Message: `Dead exclude entry: "path/to/dead_file.proto" matches no target files.`,
+				},
+				{
+					Pos: meta.Position{
+						Filename: problematicPath,
+						Line:     35,
+						Column:   9,
+					},
+					Message: `Dead exclude entry: "path/dead" matches no directories of the target files.`,
+				},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, got, err := config.ValidateExternalConfig(
+				test.inputFilePath,
+				"",
+				ruleIDs,
+				allRules.OptionDocs(),
+				test.inputDisplayPaths,
+			)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantErrs) {
+				t.Errorf("got %v, but want %v", got, test.wantErrs)
+			}
+		})
+	}
+}
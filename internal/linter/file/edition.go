@@ -0,0 +1,82 @@
+package file
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// editionStatement matches the edition statement like `edition = "2023";`.
+var editionStatement = regexp.MustCompile(`^edition\s*=\s*(?:"([^"]*)"|'([^']*)')\s*;`)
+
+// editionSyntaxStatements are the syntax statements to replace the edition statement with, from the longest one.
+var editionSyntaxStatements = []string{
+	`syntax = "proto2";`,
+	`syntax ="proto2";`,
+	`syntax="proto2";`,
+}
+
+// passThroughEdition replaces the edition statement of the content with a syntax statement.
+// The parser only understands proto2 and proto3 while the editions syntax is close enough to proto2.
+// It returns the edition, or empty if the content has no edition statement.
+//
+// The replacement is padded to the length of the edition statement to keep the positions of the rest intact,
+// which holds unless the statement is written without any spaces.
+func passThroughEdition(content []byte) ([]byte, string) {
+	start := skipSpacesAndComments(content)
+	match := editionStatement.FindSubmatchIndex(content[start:])
+	if match == nil {
+		return content, ""
+	}
+	end := start + match[1]
+	quoted := match[2:4]
+	if quoted[0] < 0 {
+		quoted = match[4:6]
+	}
+	edition := string(content[start+quoted[0] : start+quoted[1]])
+
+	statement := content[start:end]
+	newlines := bytes.Count(statement, []byte("\n"))
+	replacement := editionSyntaxStatements[len(editionSyntaxStatements)-1]
+	for _, s := range editionSyntaxStatements {
+		if len(s)+newlines <= len(statement) {
+			replacement = s
+			break
+		}
+	}
+	if padding := len(statement) - len(replacement) - newlines; 0 < padding {
+		replacement += string(bytes.Repeat([]byte(" "), padding))
+	}
+	replacement += string(bytes.Repeat([]byte("\n"), newlines))
+
+	var passed []byte
+	passed = append(passed, content[:start]...)
+	passed = append(passed, replacement...)
+	passed = append(passed, content[end:]...)
+	return passed, edition
+}
+
+// skipSpacesAndComments returns the offset of the first token of the content.
+func skipSpacesAndComments(content []byte) int {
+	i := 0
+	for i < len(content) {
+		switch {
+		case bytes.IndexByte([]byte(" \t\r\n\f\v"), content[i]) != -1:
+			i++
+		case bytes.HasPrefix(content[i:], []byte("//")):
+			end := bytes.IndexByte(content[i:], '\n')
+			if end == -1 {
+				return len(content)
+			}
+			i += end + 1
+		case bytes.HasPrefix(content[i:], []byte("/*")):
+			end := bytes.Index(content[i+2:], []byte("*/"))
+			if end == -1 {
+				return len(content)
+			}
+			i += 2 + end + 2
+		default:
+			return i
+		}
+	}
+	return i
+}
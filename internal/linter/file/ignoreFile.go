@@ -0,0 +1,116 @@
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tyhal/protolint/internal/pathutil"
+)
+
+// ignoreRule is a pattern line in a file in the gitignore format.
+type ignoreRule struct {
+	// The absolute and slash-separated glob pattern.
+	pattern string
+	negated bool
+	dirOnly bool
+}
+
+type ignoreRules []ignoreRule
+
+// readIgnoreRules reads the ignore file in the directory.
+// It returns no rules without an error if the file does not exist.
+func readIgnoreRules(
+	absDirPath string,
+	fileName string,
+) (ignoreRules, error) {
+	data, err := ioutil.ReadFile(filepath.Join(absDirPath, fileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var rules ignoreRules
+	for _, line := range strings.Split(string(data), "\n") {
+		if rule, ok := parseIgnoreRule(absDirPath, line); ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// parseIgnoreRule parses the line following the gitignore format.
+// See https://git-scm.com/docs/gitignore#_pattern_format.
+func parseIgnoreRule(
+	absDirPath string,
+	line string,
+) (ignoreRule, bool) {
+	line = strings.TrimRight(line, "\r")
+	if !strings.HasSuffix(line, `\ `) {
+		line = strings.TrimRight(line, " ")
+	}
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	var rule ignoreRule
+	switch {
+	case strings.HasPrefix(line, "!"):
+		rule.negated = true
+		line = line[1:]
+	case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+
+	base := escapeGlob(filepath.ToSlash(absDirPath))
+	if strings.Contains(line, "/") {
+		// A pattern including a slash is relative to the directory of the ignore file.
+		rule.pattern = base + "/" + strings.TrimPrefix(line, "/")
+	} else {
+		// Otherwise, the pattern matches at any level below the directory.
+		rule.pattern = base + "/**/" + line
+	}
+	return rule, true
+}
+
+// match reports whether the path is ignored. The last matching rule decides the result.
+func (rs ignoreRules) match(
+	absPath string,
+	isDir bool,
+) bool {
+	matched := false
+	for _, rule := range rs {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if matched == !rule.negated {
+			continue
+		}
+		if pathutil.Match(rule.pattern, absPath) {
+			matched = !rule.negated
+		}
+	}
+	return matched
+}
+
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package file
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// ParsedProtoSet represents the parsed files of a linted set.
+// The rules verifying the consistency across the files look at the other files through it.
+// The files are parsed lazily and only once.
+type ParsedProtoSet struct {
+	protoFiles []ProtoFile
+	protos     []*parser.Proto
+	parsed     bool
+}
+
+// NewParsedProtoSet creates a new ParsedProtoSet.
+func NewParsedProtoSet(
+	protoFiles []ProtoFile,
+) *ParsedProtoSet {
+	return &ParsedProtoSet{
+		protoFiles: protoFiles,
+	}
+}
+
+// Protos returns the parsed files in the order of the set.
+// The files failing to parse are left out because they are reported by the lint of themselves.
+// A nil set returns no files.
+func (s *ParsedProtoSet) Protos() []*parser.Proto {
+	if s == nil {
+		return nil
+	}
+	if s.parsed {
+		return s.protos
+	}
+	s.parsed = true
+
+	for _, f := range s.protoFiles {
+		proto, err := f.Parse(false)
+		if err != nil {
+			continue
+		}
+		s.protos = append(s.protos, proto)
+	}
+	return s.protos
+}
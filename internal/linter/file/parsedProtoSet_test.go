@@ -0,0 +1,55 @@
+package file_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+func TestParsedProtoSet_Protos(t *testing.T) {
+	newProtoFile := func(name string) file.ProtoFile {
+		return file.NewProtoFile(setting_test.TestDataPath("rules", "fileOptions", name), name)
+	}
+
+	tests := []struct {
+		name          string
+		inputProtoSet *file.ParsedProtoSet
+		wantFilenames []string
+	}{
+		{
+			name: "nil set returns no files",
+		},
+		{
+			name: "the files failing to parse are left out",
+			inputProtoSet: file.NewParsedProtoSet([]file.ProtoFile{
+				newProtoFile("book.proto"),
+				newProtoFile("invalid.proto"),
+				newProtoFile("store.proto"),
+			}),
+			wantFilenames: []string{
+				"book.proto",
+				"store.proto",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for _, proto := range test.inputProtoSet.Protos() {
+				got = append(got, proto.Meta.Filename)
+			}
+			if !reflect.DeepEqual(got, test.wantFilenames) {
+				t.Errorf("got %v, but want %v", got, test.wantFilenames)
+			}
+
+			protos := test.inputProtoSet.Protos()
+			if len(protos) != len(got) {
+				t.Errorf("got %d files in the second call, but want %d", len(protos), len(got))
+			}
+		})
+	}
+}
@@ -1,7 +1,8 @@
 package file
 
 import (
-	"os"
+	"bytes"
+	"io/ioutil"
 
 	protoparser "github.com/yoheimuta/go-protoparser/v4"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
@@ -31,25 +32,18 @@ func NewProtoFile(
 }
 
 // Parse parses a Protocol Buffer file.
+// The edition like "2023" of the file with the edition statement is set to the protobuf version of the syntax.
 func (f ProtoFile) Parse(
 	debug bool,
-) (_ *parser.Proto, err error) {
-	reader, err := os.Open(f.path)
+) (*parser.Proto, error) {
+	content, err := ioutil.ReadFile(f.path)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		closeErr := reader.Close()
-		if err != nil {
-			return
-		}
-		if closeErr != nil {
-			err = closeErr
-		}
-	}()
+	content, edition := passThroughEdition(content)
 
 	proto, err := protoparser.Parse(
-		reader,
+		bytes.NewReader(content),
 		protoparser.WithFilename(f.displayPath),
 		protoparser.WithBodyIncludingComments(true),
 		protoparser.WithDebug(debug),
@@ -57,6 +51,9 @@ func (f ProtoFile) Parse(
 	if err != nil {
 		return nil, err
 	}
+	if edition != "" {
+		proto.Syntax.ProtobufVersion = edition
+	}
 	return proto, nil
 }
 
@@ -0,0 +1,118 @@
+package file_test
+
+import (
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/tyhal/protolint/internal/linter/file"
+	"github.com/tyhal/protolint/internal/setting_test"
+)
+
+func TestProtoFile_Parse(t *testing.T) {
+	tests := []struct {
+		name             string
+		inputFilename    string
+		wantVersion      string
+		wantSyntaxPos    meta.Position
+		wantPackagePos   meta.Position
+		wantSyntaxHasDoc bool
+		wantExistErr     bool
+	}{
+		{
+			name:          "not found proto file",
+			inputFilename: "not_found.proto",
+			wantExistErr:  true,
+		},
+		{
+			name:          "the syntax is parsed as it is",
+			inputFilename: "syntax.proto",
+			wantVersion:   "proto3",
+			wantSyntaxPos: meta.Position{
+				Filename: "syntax.proto",
+				Offset:   0,
+				Line:     1,
+				Column:   1,
+			},
+			wantPackagePos: meta.Position{
+				Filename: "syntax.proto",
+				Offset:   20,
+				Line:     3,
+				Column:   1,
+			},
+		},
+		{
+			name:          "the edition is passed through as the version with the positions intact",
+			inputFilename: "edition.proto",
+			wantVersion:   "2023",
+			wantSyntaxPos: meta.Position{
+				Filename: "edition.proto",
+				Offset:   45,
+				Line:     2,
+				Column:   1,
+			},
+			wantPackagePos: meta.Position{
+				Filename: "edition.proto",
+				Offset:   64,
+				Line:     4,
+				Column:   1,
+			},
+			wantSyntaxHasDoc: true,
+		},
+		{
+			name:          "the edition without any spaces is passed through as the version with the lines intact",
+			inputFilename: "edition_compact.proto",
+			wantVersion:   "2023",
+			wantSyntaxPos: meta.Position{
+				Filename: "edition_compact.proto",
+				Offset:   0,
+				Line:     1,
+				Column:   1,
+			},
+			wantPackagePos: meta.Position{
+				Filename: "edition_compact.proto",
+				Offset:   16,
+				Line:     1,
+				Column:   17,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			f := file.NewProtoFile(setting_test.TestDataPath("parser", test.inputFilename), test.inputFilename)
+
+			got, err := f.Parse(false)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			if got.Syntax.ProtobufVersion != test.wantVersion {
+				t.Errorf("got %v, but want %v", got.Syntax.ProtobufVersion, test.wantVersion)
+			}
+			if got.Syntax.Meta.Pos != test.wantSyntaxPos {
+				t.Errorf("got %v, but want %v", got.Syntax.Meta.Pos, test.wantSyntaxPos)
+			}
+			if hasDoc := 0 < len(got.Syntax.Comments); hasDoc != test.wantSyntaxHasDoc {
+				t.Errorf("got %v, but want %v", hasDoc, test.wantSyntaxHasDoc)
+			}
+			pkg, ok := got.ProtoBody[0].(*parser.Package)
+			if !ok {
+				t.Errorf("got %T, but want *parser.Package", got.ProtoBody[0])
+				return
+			}
+			if pkg.Meta.Pos != test.wantPackagePos {
+				t.Errorf("got %v, but want %v", pkg.Meta.Pos, test.wantPackagePos)
+			}
+		})
+	}
+}
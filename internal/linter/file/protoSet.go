@@ -2,8 +2,11 @@ package file
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 )
 
 // ProtoSet represents a set of .proto files.
@@ -12,14 +15,20 @@ type ProtoSet struct {
 }
 
 // NewProtoSet creates a new ProtoSet.
+// The excluded directories are not walked, so that the files inside them are never parsed.
+// It is not an error that all the files are excluded, but it is that no file is found.
 func NewProtoSet(
 	targetPaths []string,
+	opts ...ProtoSetOption,
 ) (ProtoSet, error) {
-	fs, err := collectAllProtoFilesFromArgs(targetPaths)
+	fs, foundCount, excluded, err := collectAllProtoFilesFromArgs(
+		targetPaths,
+		newProtoSetConfig(opts),
+	)
 	if err != nil {
 		return ProtoSet{}, err
 	}
-	if len(fs) == 0 {
+	if foundCount == 0 && !excluded {
 		return ProtoSet{}, fmt.Errorf("not found protocol buffer files in %v", targetPaths)
 	}
 
@@ -35,61 +44,240 @@ func (s ProtoSet) ProtoFiles() []ProtoFile {
 
 func collectAllProtoFilesFromArgs(
 	targetPaths []string,
-) ([]ProtoFile, error) {
+	config protoSetConfig,
+) ([]ProtoFile, int, bool, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
-		return nil, err
+		return nil, 0, false, err
 	}
 	absCwd, err := absClean(cwd)
 	if err != nil {
-		return nil, err
+		return nil, 0, false, err
 	}
 
 	var fs []ProtoFile
+	var foundCount int
+	var excluded bool
 	for _, path := range targetPaths {
 		absTarget, err := absClean(path)
 		if err != nil {
-			return nil, err
+			return nil, 0, false, err
 		}
 
-		f, err := collectAllProtoFiles(absCwd, absTarget)
-		if err != nil {
-			return nil, err
+		w := newProtoWalker(absCwd, config)
+		if err := w.loadParentIgnoreRules(absTarget); err != nil {
+			return nil, 0, false, err
+		}
+		if err := w.walk(absTarget); err != nil {
+			return nil, 0, false, err
 		}
-		fs = append(fs, f...)
+		fs = append(fs, w.protoFiles...)
+		foundCount += w.foundCount
+		excluded = excluded || w.excluded
 	}
-	return fs, nil
+	return fs, foundCount, excluded, nil
+}
+
+// protoWalker walks the file tree to collect .proto files.
+// Unlike filepath.Walk, it follows symbolic links and walks each directory only once,
+// so that neither a link pointing to its ancestor nor a link to a sibling collects the files twice.
+type protoWalker struct {
+	absWorkDirPath string
+	config         protoSetConfig
+	ignoreRules    ignoreRules
+	// The real paths of the directories already walked.
+	walkedDirs map[string]bool
+
+	protoFiles []ProtoFile
+	// The number of .proto files found before excluding them.
+	foundCount int
+	// Whether or not any directory or .proto file is excluded.
+	excluded bool
 }
 
-func collectAllProtoFiles(
+func newProtoWalker(
 	absWorkDirPath string,
+	config protoSetConfig,
+) *protoWalker {
+	return &protoWalker{
+		absWorkDirPath: absWorkDirPath,
+		config:         config,
+		walkedDirs:     make(map[string]bool),
+	}
+}
+
+// loadParentIgnoreRules loads the ignore files in the directories
+// from the root to the parent of the target.
+func (w *protoWalker) loadParentIgnoreRules(absTargetPath string) error {
+	if len(w.config.ignoreFileNames) == 0 {
+		return nil
+	}
+	root, ok := w.ignoreRoot(absTargetPath)
+	if !ok {
+		return nil
+	}
+
+	rel, err := filepath.Rel(root, filepath.Dir(absTargetPath))
+	if err != nil {
+		return nil
+	}
+	dir := root
+	dirs := []string{dir}
+	if rel != "." {
+		for _, elem := range strings.Split(rel, string(os.PathSeparator)) {
+			dir = filepath.Join(dir, elem)
+			dirs = append(dirs, dir)
+		}
+	}
+	for _, dir := range dirs {
+		if err := w.loadIgnoreRules(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ignoreRoot returns the directory above the target whose ignore files apply to it.
+// It is the repository root with .git, or else the root directory set by the option or the working directory if they include the target.
+func (w *protoWalker) ignoreRoot(absTargetPath string) (string, bool) {
+	for dir := filepath.Dir(absTargetPath); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, true
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			break
+		}
+	}
+
+	for _, dir := range []string{w.config.ignoreRootDir, w.absWorkDirPath} {
+		if dir == "" {
+			continue
+		}
+		absDir, err := absClean(dir)
+		if err != nil {
+			continue
+		}
+		if isAncestor(absDir, absTargetPath) {
+			return absDir, true
+		}
+	}
+	return "", false
+}
+
+// isAncestor decides whether or not the directory includes the path.
+func isAncestor(
+	absDirPath string,
 	absPath string,
-) ([]ProtoFile, error) {
-	var fs []ProtoFile
+) bool {
+	rel, err := filepath.Rel(absDirPath, absPath)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator))
+}
 
-	err := filepath.Walk(
-		absPath,
-		func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return err
-			}
-			if filepath.Ext(path) != ".proto" {
+func (w *protoWalker) loadIgnoreRules(absDirPath string) error {
+	for _, name := range w.config.ignoreFileNames {
+		rules, err := readIgnoreRules(absDirPath, name)
+		if err != nil {
+			return err
+		}
+		w.ignoreRules = append(w.ignoreRules, rules...)
+	}
+	return nil
+}
+
+func (w *protoWalker) walk(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+	return w.visit(path, info)
+}
+
+func (w *protoWalker) visit(
+	path string,
+	info os.FileInfo,
+) error {
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Stat(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Skip a dangling symbolic link.
 				return nil
 			}
+			return err
+		}
+		info = target
+	}
 
-			displayPath, err := filepath.Rel(absWorkDirPath, path)
-			if err != nil {
-				displayPath = path
-			}
-			displayPath = filepath.Clean(displayPath)
-			fs = append(fs, NewProtoFile(path, displayPath))
-			return nil
-		},
-	)
+	if info.IsDir() {
+		return w.visitDir(path)
+	}
+	w.visitFile(path)
+	return nil
+}
+
+func (w *protoWalker) visitDir(path string) error {
+	if w.config.skipDir(w.displayPath(path)) || w.ignoreRules.match(path, true) {
+		w.excluded = true
+		return nil
+	}
+
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return err
+	}
+	if w.walkedDirs[realPath] {
+		// Stop walking a symbolic link loop or a directory reached through another link.
+		return nil
+	}
+	w.walkedDirs[realPath] = true
+
+	ruleCount := len(w.ignoreRules)
+	defer func() {
+		w.ignoreRules = w.ignoreRules[:ruleCount]
+	}()
+	if err := w.loadIgnoreRules(path); err != nil {
+		return err
+	}
+
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	// Walk the real entries before the symbolic links, so that the files are collected by their own paths if possible.
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].Mode()&os.ModeSymlink == 0 && infos[j].Mode()&os.ModeSymlink != 0
+	})
+	for _, info := range infos {
+		if err := w.visit(filepath.Join(path, info.Name()), info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *protoWalker) visitFile(path string) {
+	if filepath.Ext(path) != ".proto" {
+		return
+	}
+	w.foundCount++
+
+	displayPath := w.displayPath(path)
+	if w.config.skipFile(displayPath) || w.ignoreRules.match(path, false) {
+		w.excluded = true
+		return
+	}
+	w.protoFiles = append(w.protoFiles, NewProtoFile(path, displayPath))
+}
+
+func (w *protoWalker) displayPath(path string) string {
+	displayPath, err := filepath.Rel(w.absWorkDirPath, path)
 	if err != nil {
-		return nil, err
+		displayPath = path
 	}
-	return fs, nil
+	return filepath.Clean(displayPath)
 }
 
 // absClean returns the cleaned absolute path of the given path.
@@ -0,0 +1,52 @@
+package file
+
+// ProtoSetOption is an option for NewProtoSet.
+type ProtoSetOption func(*protoSetConfig)
+
+type protoSetConfig struct {
+	skipDir         func(displayPath string) bool
+	skipFile        func(displayPath string) bool
+	ignoreFileNames []string
+	ignoreRootDir   string
+}
+
+// WithSkipDir sets the function to decide whether to skip walking the directory.
+func WithSkipDir(skipDir func(displayPath string) bool) ProtoSetOption {
+	return func(c *protoSetConfig) {
+		c.skipDir = skipDir
+	}
+}
+
+// WithSkipFile sets the function to decide whether to exclude the file from the set.
+func WithSkipFile(skipFile func(displayPath string) bool) ProtoSetOption {
+	return func(c *protoSetConfig) {
+		c.skipFile = skipFile
+	}
+}
+
+// WithIgnoreFiles sets the names of the files in the gitignore format, like ".gitignore".
+// The files found while walking are applied to the directory including it and its descendants.
+func WithIgnoreFiles(names ...string) ProtoSetOption {
+	return func(c *protoSetConfig) {
+		c.ignoreFileNames = append(c.ignoreFileNames, names...)
+	}
+}
+
+// WithIgnoreRootDir sets the directory whose ignore files and its descendants' ones apply to the targets inside it,
+// like the directory of the config file. It is used when no repository root with .git is found above the targets.
+func WithIgnoreRootDir(dir string) ProtoSetOption {
+	return func(c *protoSetConfig) {
+		c.ignoreRootDir = dir
+	}
+}
+
+func newProtoSetConfig(opts []ProtoSetOption) protoSetConfig {
+	c := protoSetConfig{
+		skipDir:  func(string) bool { return false },
+		skipFile: func(string) bool { return false },
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
@@ -1,7 +1,10 @@
 package file_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/tyhal/protolint/internal/linter/file"
@@ -9,9 +12,12 @@ import (
 )
 
 func TestNewProtoSet(t *testing.T) {
+	walkDir := setting_test.TestDataPath("testwalk")
+
 	tests := []struct {
 		name             string
 		inputTargetPaths []string
+		inputOptions     []file.ProtoSetOption
 		wantProtoFiles   []file.ProtoFile
 		wantExistErr     bool
 	}{
@@ -61,12 +67,83 @@ func TestNewProtoSet(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "testwalk follows symbolic links and walks each directory once",
+			inputTargetPaths: []string{
+				walkDir,
+			},
+			wantProtoFiles: []file.ProtoFile{
+				file.NewProtoFile(
+					filepath.Join(walkDir, "a.proto"),
+					"../../../_testdata/testwalk/a.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(walkDir, "generated", "c.proto"),
+					"../../../_testdata/testwalk/generated/c.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(walkDir, "nested", "d.proto"),
+					"../../../_testdata/testwalk/nested/d.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(walkDir, "vendor", "b.proto"),
+					"../../../_testdata/testwalk/vendor/b.proto",
+				),
+			},
+		},
+		{
+			name: "testwalk skips the excluded directories and the ignored files",
+			inputTargetPaths: []string{
+				walkDir,
+			},
+			inputOptions: []file.ProtoSetOption{
+				file.WithSkipDir(func(displayPath string) bool {
+					return filepath.Base(displayPath) == "vendor"
+				}),
+				file.WithSkipFile(func(displayPath string) bool {
+					return filepath.Base(filepath.Dir(displayPath)) == "linked"
+				}),
+				file.WithIgnoreFiles(".protolintignore"),
+			},
+			wantProtoFiles: []file.ProtoFile{
+				file.NewProtoFile(
+					filepath.Join(walkDir, "a.proto"),
+					"../../../_testdata/testwalk/a.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(walkDir, "nested", "d.proto"),
+					"../../../_testdata/testwalk/nested/d.proto",
+				),
+			},
+		},
+		{
+			name: "testwalk includes proto files which are all excluded",
+			inputTargetPaths: []string{
+				walkDir,
+			},
+			inputOptions: []file.ProtoSetOption{
+				file.WithSkipFile(func(string) bool {
+					return true
+				}),
+			},
+		},
+		{
+			name: "testwalk includes directories which are all excluded",
+			inputTargetPaths: []string{
+				walkDir,
+			},
+			inputOptions: []file.ProtoSetOption{
+				file.WithSkipDir(func(string) bool {
+					return true
+				}),
+			},
+		},
 	}
 
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			got, err := file.NewProtoSet(test.inputTargetPaths)
+			got, err := file.NewProtoSet(test.inputTargetPaths, test.inputOptions...)
 			if test.wantExistErr {
 				if err == nil {
 					t.Errorf("got err nil, but want err")
@@ -78,6 +155,10 @@ func TestNewProtoSet(t *testing.T) {
 				return
 			}
 
+			if len(got.ProtoFiles()) != len(test.wantProtoFiles) {
+				t.Errorf("got %v files, but want %v files", len(got.ProtoFiles()), len(test.wantProtoFiles))
+				return
+			}
 			for i, gotf := range got.ProtoFiles() {
 				wantf := test.wantProtoFiles[i]
 				if gotf.Path() != wantf.Path() {
@@ -90,3 +171,94 @@ func TestNewProtoSet(t *testing.T) {
 		})
 	}
 }
+
+func TestNewProtoSet_ignoreRoot(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputGitRoot   bool
+		inputRootDir   bool
+		wantProtoFiles []string
+	}{
+		{
+			name:         "apply the ignore files from the repository root",
+			inputGitRoot: true,
+			wantProtoFiles: []string{
+				"a.proto",
+			},
+		},
+		{
+			name:         "apply the ignore files from the root directory without the repository root",
+			inputRootDir: true,
+			wantProtoFiles: []string{
+				"a.proto",
+			},
+		},
+		{
+			name: "not apply the ignore files above the target outside the working directory",
+			wantProtoFiles: []string{
+				"a.proto",
+				filepath.Join("generated", "b.proto"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			root, err := ioutil.TempDir("", "protoset")
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			defer func() {
+				_ = os.RemoveAll(root)
+			}()
+
+			target := filepath.Join(root, "proto")
+			files := map[string]string{
+				filepath.Join(root, ".gitignore"):               "generated/\n",
+				filepath.Join(target, "a.proto"):                `syntax = "proto3";`,
+				filepath.Join(target, "generated", "b.proto"):   `syntax = "proto3";`,
+				filepath.Join(root, "unrelated", "other.proto"): `syntax = "proto3";`,
+			}
+			if test.inputGitRoot {
+				files[filepath.Join(root, ".git", "HEAD")] = "ref: refs/heads/main\n"
+			}
+			for path, content := range files {
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Errorf("got err %v, but want nil", err)
+					return
+				}
+				if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Errorf("got err %v, but want nil", err)
+					return
+				}
+			}
+
+			opts := []file.ProtoSetOption{
+				file.WithIgnoreFiles(".gitignore"),
+			}
+			if test.inputRootDir {
+				opts = append(opts, file.WithIgnoreRootDir(root))
+			}
+			got, err := file.NewProtoSet([]string{target}, opts...)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			var gotPaths []string
+			for _, f := range got.ProtoFiles() {
+				rel, err := filepath.Rel(target, f.Path())
+				if err != nil {
+					t.Errorf("got err %v, but want nil", err)
+					return
+				}
+				gotPaths = append(gotPaths, rel)
+			}
+			if !reflect.DeepEqual(gotPaths, test.wantProtoFiles) {
+				t.Errorf("got %v, but want %v", gotPaths, test.wantProtoFiles)
+			}
+		})
+	}
+}
@@ -28,3 +28,15 @@ func ruleIDs(rules []rule.Rule) []string {
 	}
 	return ids
 }
+
+// OptionDocs returns the docs of the rule options keyed by the rule IDs.
+// The rules without the docs, like some of the plugin rules, are left out.
+func (rs Rules) OptionDocs() map[string][]rule.OptionDoc {
+	docs := make(map[string][]rule.OptionDoc)
+	for _, r := range rs {
+		if d, ok := r.(rule.HasDoc); ok {
+			docs[r.ID()] = d.Doc().Options
+		}
+	}
+	return docs
+}
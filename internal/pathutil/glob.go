@@ -0,0 +1,143 @@
+// Package pathutil provides the glob matching used for the configured paths.
+package pathutil
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	doubleStar     = "**"
+	negationPrefix = "!"
+)
+
+// Match reports whether the path matches the glob pattern.
+//
+// The pattern syntax is that of path.Match for each path segment, plus
+// the "**" segment which matches zero or more segments.
+// A pattern without any meta characters works as an exact match.
+// Both the pattern and the path are compared in the slash-separated and cleaned form.
+func Match(
+	pattern string,
+	name string,
+) bool {
+	return matchSegments(
+		splitSegments(pattern),
+		splitSegments(name),
+	)
+}
+
+// Normalize converts the path to the form used by Match.
+func Normalize(name string) string {
+	name = filepath.ToSlash(name)
+	if name == "" {
+		return name
+	}
+	return path.Clean(name)
+}
+
+func splitSegments(name string) []string {
+	name = Normalize(name)
+	if name == "" || name == "." {
+		return nil
+	}
+	return strings.Split(name, "/")
+}
+
+func matchSegments(
+	patterns []string,
+	names []string,
+) bool {
+	for len(patterns) > 0 {
+		p := patterns[0]
+		if p == doubleStar {
+			rest := patterns[1:]
+			for i := 0; i <= len(names); i++ {
+				if matchSegments(rest, names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(names) == 0 {
+			return false
+		}
+		ok, err := path.Match(p, names[0])
+		if err != nil || !ok {
+			return false
+		}
+		patterns = patterns[1:]
+		names = names[1:]
+	}
+	return len(names) == 0
+}
+
+// Patterns represents an ordered list of glob patterns.
+//
+// A pattern prefixed with "!" negates the match, so that it can re-include the path
+// excluded by the preceding patterns. The last matching pattern decides the result.
+type Patterns []string
+
+// Match reports whether the path is matched by the patterns.
+func (ps Patterns) Match(name string) bool {
+	return ps.match(func(pattern string) bool {
+		return Match(pattern, name)
+	})
+}
+
+// MatchDir reports whether any parent directory of the path is matched by the patterns.
+func (ps Patterns) MatchDir(name string) bool {
+	dirs := parentDirs(name)
+	return ps.match(func(pattern string) bool {
+		for _, dir := range dirs {
+			if Match(pattern, dir) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// HasNegation reports whether the patterns include a negated pattern.
+func (ps Patterns) HasNegation() bool {
+	for _, pattern := range ps {
+		if strings.HasPrefix(pattern, negationPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ps Patterns) match(
+	matchFunc func(pattern string) bool,
+) bool {
+	matched := false
+	for _, pattern := range ps {
+		negated := strings.HasPrefix(pattern, negationPrefix)
+		if negated {
+			pattern = strings.TrimPrefix(pattern, negationPrefix)
+		}
+		if matched == !negated {
+			continue
+		}
+		if matchFunc(pattern) {
+			matched = !negated
+		}
+	}
+	return matched
+}
+
+func parentDirs(name string) []string {
+	var dirs []string
+	dir := path.Dir(Normalize(name))
+	for dir != "." && dir != "/" {
+		dirs = append(dirs, dir)
+		parent := path.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	return dirs
+}
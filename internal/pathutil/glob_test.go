@@ -0,0 +1,207 @@
+package pathutil_test
+
+import (
+	"testing"
+
+	"github.com/tyhal/protolint/internal/pathutil"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputPattern string
+		inputPath    string
+		wantMatch    bool
+	}{
+		{
+			name:         "exact match",
+			inputPattern: "path/to/foo.proto",
+			inputPath:    "path/to/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "exact mismatch",
+			inputPattern: "path/to/foo.proto",
+			inputPath:    "path/to/bar.proto",
+		},
+		{
+			name:         "single star does not cross directories",
+			inputPattern: "path/*.proto",
+			inputPath:    "path/to/foo.proto",
+		},
+		{
+			name:         "single star in a segment",
+			inputPattern: "path/to/*.proto",
+			inputPath:    "path/to/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "double star matches nested directories",
+			inputPattern: "third_party/**/*.proto",
+			inputPath:    "third_party/a/b/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "double star matches zero directories",
+			inputPattern: "third_party/**/*.proto",
+			inputPath:    "third_party/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "leading double star matches a path outside the working directory",
+			inputPattern: "**/vendor/**",
+			inputPath:    "../../vendor/github.com/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "trailing double star matches the directory itself",
+			inputPattern: "**/vendor/**",
+			inputPath:    "a/vendor",
+			wantMatch:    true,
+		},
+		{
+			name:         "not cleaned path",
+			inputPattern: "path/to/foo.proto",
+			inputPath:    "./path//to/foo.proto",
+			wantMatch:    true,
+		},
+		{
+			name:         "malformed pattern",
+			inputPattern: "path/[",
+			inputPath:    "path/[",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := pathutil.Match(test.inputPattern, test.inputPath)
+			if got != test.wantMatch {
+				t.Errorf("got %v, but want %v", got, test.wantMatch)
+			}
+		})
+	}
+}
+
+func TestPatterns_Match(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPatterns pathutil.Patterns
+		inputPath     string
+		wantMatch     bool
+	}{
+		{
+			name:      "no patterns",
+			inputPath: "path/to/foo.proto",
+		},
+		{
+			name: "matched",
+			inputPatterns: pathutil.Patterns{
+				"path/**/*.proto",
+			},
+			inputPath: "path/to/foo.proto",
+			wantMatch: true,
+		},
+		{
+			name: "negated after matched",
+			inputPatterns: pathutil.Patterns{
+				"path/**/*.proto",
+				"!path/to/foo.proto",
+			},
+			inputPath: "path/to/foo.proto",
+		},
+		{
+			name: "matched again after negated",
+			inputPatterns: pathutil.Patterns{
+				"path/**/*.proto",
+				"!path/to/*.proto",
+				"path/to/foo.proto",
+			},
+			inputPath: "path/to/foo.proto",
+			wantMatch: true,
+		},
+		{
+			name: "negation only",
+			inputPatterns: pathutil.Patterns{
+				"!path/to/foo.proto",
+			},
+			inputPath: "path/to/foo.proto",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := test.inputPatterns.Match(test.inputPath)
+			if got != test.wantMatch {
+				t.Errorf("got %v, but want %v", got, test.wantMatch)
+			}
+		})
+	}
+}
+
+func TestPatterns_MatchDir(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPatterns pathutil.Patterns
+		inputPath     string
+		wantMatch     bool
+	}{
+		{
+			name: "parent directory",
+			inputPatterns: pathutil.Patterns{
+				"path/to/dir",
+			},
+			inputPath: "path/to/dir/foo.proto",
+			wantMatch: true,
+		},
+		{
+			name: "ancestor directory",
+			inputPatterns: pathutil.Patterns{
+				"path/to",
+			},
+			inputPath: "path/to/dir/foo.proto",
+			wantMatch: true,
+		},
+		{
+			name: "the file itself is not a directory",
+			inputPatterns: pathutil.Patterns{
+				"path/to/foo.proto",
+			},
+			inputPath: "path/to/foo.proto",
+		},
+		{
+			name: "directory with a similar prefix",
+			inputPatterns: pathutil.Patterns{
+				"path/to/dir",
+			},
+			inputPath: "path/to/dir2/foo.proto",
+		},
+		{
+			name: "glob directory",
+			inputPatterns: pathutil.Patterns{
+				"**/vendor",
+			},
+			inputPath: "../a/vendor/b/foo.proto",
+			wantMatch: true,
+		},
+		{
+			name: "negated directory",
+			inputPatterns: pathutil.Patterns{
+				"**/vendor",
+				"!**/vendor/acme",
+			},
+			inputPath: "a/vendor/acme/foo.proto",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := test.inputPatterns.MatchDir(test.inputPath)
+			if got != test.wantMatch {
+				t.Errorf("got %v, but want %v", got, test.wantMatch)
+			}
+		})
+	}
+}
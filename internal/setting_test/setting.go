@@ -1,8 +1,11 @@
 package setting_test
 
 import (
+	"path"
 	"path/filepath"
 	"runtime"
+
+	"github.com/tyhal/protolint/internal/linter/file"
 )
 
 func projectRootPath() string {
@@ -22,3 +25,19 @@ func TestDataPath(elem ...string) string {
 	ps = append(ps, elem...)
 	return filepath.Join(ps...)
 }
+
+// TestProtoFile is the .proto file of the data used by tests.
+// It is displayed in displayDir like the files found by walking the directory, or at the file name if displayDir is empty.
+func TestProtoFile(displayDir string, elem ...string) file.ProtoFile {
+	p := TestDataPath(elem...)
+	return file.NewProtoFile(p, path.Join(displayDir, filepath.Base(p)))
+}
+
+// ProjectPath is the path under the project root.
+func ProjectPath(elem ...string) string {
+	ps := []string{
+		projectRootPath(),
+	}
+	ps = append(ps, elem...)
+	return filepath.Join(ps...)
+}
@@ -0,0 +1,39 @@
+package rule
+
+// Doc represents the long-form documentation of a rule.
+type Doc struct {
+	// Rationale explains why the rule exists.
+	Rationale string
+	// BadExample is a snippet which the rule reports.
+	BadExample string
+	// GoodExample is a snippet which the rule accepts.
+	GoodExample string
+	// StyleGuideURL is a link to the style guide which the rule follows.
+	StyleGuideURL string
+	// Options are the options configurable under rules_option.
+	Options []OptionDoc
+}
+
+// OptionDoc represents the documentation of a rule option.
+type OptionDoc struct {
+	// Name is the key of the option.
+	Name string
+	// Description explains the option, including its default value.
+	Description string
+	// Kind is the kind of the value, which config validate verifies.
+	Kind OptionKind
+	// Values are the valid values of the option. Any value is valid if it is empty.
+	Values []string
+}
+
+// OptionKind represents the kind of a rule option value.
+type OptionKind int
+
+const (
+	// OptionKindPlain is a value verified only by its type.
+	OptionKindPlain OptionKind = iota
+	// OptionKindRegexp is a regular expression.
+	OptionKindRegexp
+	// OptionKindGlob is a pattern of path.Match.
+	OptionKindGlob
+)
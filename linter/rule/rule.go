@@ -37,3 +37,15 @@ type Rule interface {
 	HasPurpose
 	HasIsOfficial
 }
+
+// HasIsFixable represents a rule with IsFixable.
+type HasIsFixable interface {
+	// IsFixable decides whether or not this rule can fix the problems with the -fix flag.
+	IsFixable() bool
+}
+
+// HasDoc represents a rule with the long-form documentation.
+type HasDoc interface {
+	// Doc returns the long-form documentation of this rule.
+	Doc() Doc
+}
@@ -136,6 +136,32 @@ func SplitSnakeCaseWord(s string) []string {
 	return strings.Split(s, "_")
 }
 
+// ToUpperSnakeCase converts s to UPPER_SNAKE_CASE.
+//
+// If s is CamelCase, the words are joined with underscores.
+// An acronym is a word of its own, e.g. HTTPStatus becomes HTTP_STATUS.
+// Otherwise, s is only converted to upper case.
+func ToUpperSnakeCase(s string) string {
+	s = strings.TrimSpace(s)
+	if !isCamelCase(s) {
+		return strings.ToUpper(s)
+	}
+
+	rs := []rune(s)
+	var b strings.Builder
+	for i, r := range rs {
+		if 0 < i && isUpper(r) {
+			prior := rs[i-1]
+			nextLower := i+1 < len(rs) && isLower(rs[i+1])
+			if isLower(prior) || isDigit(prior) || (isUpper(prior) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
 func isLetter(r rune) bool {
 	return isUpper(r) || isLower(r)
 }
@@ -235,3 +235,60 @@ func TestSplitSnakeCaseWord(t *testing.T) {
 		})
 	}
 }
+
+func TestToUpperSnakeCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name: "if s is empty, returns empty",
+		},
+		{
+			name:  "input consists of one word",
+			input: "Color",
+			want:  "COLOR",
+		},
+		{
+			name:  "input consists of multiple words",
+			input: "ReasonForError",
+			want:  "REASON_FOR_ERROR",
+		},
+		{
+			name:  "input is lower camel case",
+			input: "bookStatus",
+			want:  "BOOK_STATUS",
+		},
+		{
+			name:  "input is snake_case",
+			input: "book_status",
+			want:  "BOOK_STATUS",
+		},
+		{
+			name:  "input begins with an acronym",
+			input: "HTTPStatus",
+			want:  "HTTP_STATUS",
+		},
+		{
+			name:  "input ends with an acronym",
+			input: "StatusHTTP",
+			want:  "STATUS_HTTP",
+		},
+		{
+			name:  "input has a digit",
+			input: "Http2Status",
+			want:  "HTTP2_STATUS",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := strs.ToUpperSnakeCase(test.input)
+			if got != test.want {
+				t.Errorf("got %v, but want %v", got, test.want)
+			}
+		})
+	}
+}